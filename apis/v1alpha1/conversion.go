@@ -0,0 +1,157 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha2"
+)
+
+// The conversion functions below wrap the functions generated by
+// conversion-gen. A field added to v1alpha2 that v1alpha1 cannot
+// represent needs a manually written Convert_v1alpha2_* function, and the
+// conversion must keep the value so that it survives a round trip
+// through v1alpha1.
+
+// ConvertTo converts this BackendPolicy to the hub version.
+func (src *BackendPolicy) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.BackendPolicy)
+	return Convert_v1alpha1_BackendPolicy_To_v1alpha2_BackendPolicy(src, dst, nil)
+}
+
+// ConvertFrom converts a BackendPolicy from the hub version to this version.
+func (dst *BackendPolicy) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.BackendPolicy)
+	return Convert_v1alpha2_BackendPolicy_To_v1alpha1_BackendPolicy(src, dst, nil)
+}
+
+// ConvertTo converts this GRPCRoute to the hub version.
+func (src *GRPCRoute) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.GRPCRoute)
+	return Convert_v1alpha1_GRPCRoute_To_v1alpha2_GRPCRoute(src, dst, nil)
+}
+
+// ConvertFrom converts a GRPCRoute from the hub version to this version.
+func (dst *GRPCRoute) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.GRPCRoute)
+	return Convert_v1alpha2_GRPCRoute_To_v1alpha1_GRPCRoute(src, dst, nil)
+}
+
+// ConvertTo converts this Gateway to the hub version.
+func (src *Gateway) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.Gateway)
+	return Convert_v1alpha1_Gateway_To_v1alpha2_Gateway(src, dst, nil)
+}
+
+// ConvertFrom converts a Gateway from the hub version to this version.
+func (dst *Gateway) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.Gateway)
+	return Convert_v1alpha2_Gateway_To_v1alpha1_Gateway(src, dst, nil)
+}
+
+// ConvertTo converts this GatewayClass to the hub version.
+func (src *GatewayClass) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.GatewayClass)
+	return Convert_v1alpha1_GatewayClass_To_v1alpha2_GatewayClass(src, dst, nil)
+}
+
+// ConvertFrom converts a GatewayClass from the hub version to this version.
+func (dst *GatewayClass) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.GatewayClass)
+	return Convert_v1alpha2_GatewayClass_To_v1alpha1_GatewayClass(src, dst, nil)
+}
+
+// ConvertTo converts this HTTPRoute to the hub version.
+func (src *HTTPRoute) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.HTTPRoute)
+	return Convert_v1alpha1_HTTPRoute_To_v1alpha2_HTTPRoute(src, dst, nil)
+}
+
+// ConvertFrom converts a HTTPRoute from the hub version to this version.
+func (dst *HTTPRoute) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.HTTPRoute)
+	return Convert_v1alpha2_HTTPRoute_To_v1alpha1_HTTPRoute(src, dst, nil)
+}
+
+// ConvertTo converts this ReferenceGrant to the hub version.
+func (src *ReferenceGrant) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.ReferenceGrant)
+	return Convert_v1alpha1_ReferenceGrant_To_v1alpha2_ReferenceGrant(src, dst, nil)
+}
+
+// ConvertFrom converts a ReferenceGrant from the hub version to this version.
+func (dst *ReferenceGrant) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.ReferenceGrant)
+	return Convert_v1alpha2_ReferenceGrant_To_v1alpha1_ReferenceGrant(src, dst, nil)
+}
+
+// ConvertTo converts this TCPRoute to the hub version.
+func (src *TCPRoute) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.TCPRoute)
+	return Convert_v1alpha1_TCPRoute_To_v1alpha2_TCPRoute(src, dst, nil)
+}
+
+// ConvertFrom converts a TCPRoute from the hub version to this version.
+func (dst *TCPRoute) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.TCPRoute)
+	return Convert_v1alpha2_TCPRoute_To_v1alpha1_TCPRoute(src, dst, nil)
+}
+
+// ConvertTo converts this TLSRoute to the hub version.
+func (src *TLSRoute) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.TLSRoute)
+	return Convert_v1alpha1_TLSRoute_To_v1alpha2_TLSRoute(src, dst, nil)
+}
+
+// ConvertFrom converts a TLSRoute from the hub version to this version.
+func (dst *TLSRoute) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.TLSRoute)
+	return Convert_v1alpha2_TLSRoute_To_v1alpha1_TLSRoute(src, dst, nil)
+}
+
+// ConvertTo converts this UDPRoute to the hub version.
+func (src *UDPRoute) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.UDPRoute)
+	return Convert_v1alpha1_UDPRoute_To_v1alpha2_UDPRoute(src, dst, nil)
+}
+
+// ConvertFrom converts a UDPRoute from the hub version to this version.
+func (dst *UDPRoute) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.UDPRoute)
+	return Convert_v1alpha2_UDPRoute_To_v1alpha1_UDPRoute(src, dst, nil)
+}
+
+// Convert_v1alpha1_RouteGatewayStatus_To_v1alpha2_RouteGatewayStatus
+// moves the gateway reference into the fields that v1alpha2 inlines to
+// key RouteStatus.Gateways.
+func Convert_v1alpha1_RouteGatewayStatus_To_v1alpha2_RouteGatewayStatus(in *RouteGatewayStatus, out *v1alpha2.RouteGatewayStatus, s apiconversion.Scope) error {
+	if err := Convert_v1alpha1_GatewayReference_To_v1alpha2_GatewayReference(&in.GatewayRef, &out.GatewayReference, s); err != nil {
+		return err
+	}
+	return autoConvert_v1alpha1_RouteGatewayStatus_To_v1alpha2_RouteGatewayStatus(in, out, s)
+}
+
+// Convert_v1alpha2_RouteGatewayStatus_To_v1alpha1_RouteGatewayStatus
+// moves the inlined gateway reference back into GatewayRef.
+func Convert_v1alpha2_RouteGatewayStatus_To_v1alpha1_RouteGatewayStatus(in *v1alpha2.RouteGatewayStatus, out *RouteGatewayStatus, s apiconversion.Scope) error {
+	if err := Convert_v1alpha2_GatewayReference_To_v1alpha1_GatewayReference(&in.GatewayReference, &out.GatewayRef, s); err != nil {
+		return err
+	}
+	return autoConvert_v1alpha2_RouteGatewayStatus_To_v1alpha1_RouteGatewayStatus(in, out, s)
+}
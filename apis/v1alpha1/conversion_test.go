@@ -0,0 +1,160 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	webhookconversion "sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha2"
+)
+
+// roundTrips is the number of fuzzed objects converted for each kind.
+const roundTrips = 200
+
+// convertiblePair holds constructors for a kind in this version and in
+// the hub version.
+type convertiblePair struct {
+	spoke func() conversion.Convertible
+	hub   func() conversion.Hub
+}
+
+var convertibles = map[string]convertiblePair{
+	"BackendPolicy":  {func() conversion.Convertible { return &BackendPolicy{} }, func() conversion.Hub { return &v1alpha2.BackendPolicy{} }},
+	"GRPCRoute":      {func() conversion.Convertible { return &GRPCRoute{} }, func() conversion.Hub { return &v1alpha2.GRPCRoute{} }},
+	"Gateway":        {func() conversion.Convertible { return &Gateway{} }, func() conversion.Hub { return &v1alpha2.Gateway{} }},
+	"GatewayClass":   {func() conversion.Convertible { return &GatewayClass{} }, func() conversion.Hub { return &v1alpha2.GatewayClass{} }},
+	"HTTPRoute":      {func() conversion.Convertible { return &HTTPRoute{} }, func() conversion.Hub { return &v1alpha2.HTTPRoute{} }},
+	"ReferenceGrant": {func() conversion.Convertible { return &ReferenceGrant{} }, func() conversion.Hub { return &v1alpha2.ReferenceGrant{} }},
+	"TCPRoute":       {func() conversion.Convertible { return &TCPRoute{} }, func() conversion.Hub { return &v1alpha2.TCPRoute{} }},
+	"TLSRoute":       {func() conversion.Convertible { return &TLSRoute{} }, func() conversion.Hub { return &v1alpha2.TLSRoute{} }},
+	"UDPRoute":       {func() conversion.Convertible { return &UDPRoute{} }, func() conversion.Hub { return &v1alpha2.UDPRoute{} }},
+}
+
+func TestIsConvertible(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha2.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	for kind, c := range convertibles {
+		ok, err := webhookconversion.IsConvertible(s, c.spoke())
+		if err != nil || !ok {
+			t.Errorf("%s: IsConvertible = %v, %v; want true, nil", kind, ok, err)
+		}
+	}
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	f := fuzz.New().NilChance(0.2).NumElements(0, 3)
+
+	for kind, c := range convertibles {
+		t.Run(kind, func(t *testing.T) {
+			for i := 0; i < roundTrips; i++ {
+				spoke := c.spoke()
+				f.Fuzz(spoke)
+				// Conversions leave apiVersion and kind to the caller.
+				spoke.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+				hub := c.hub()
+				if err := spoke.ConvertTo(hub); err != nil {
+					t.Fatalf("ConvertTo: %v", err)
+				}
+				back := c.spoke()
+				if err := back.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom: %v", err)
+				}
+				if !equality.Semantic.DeepEqual(spoke, back) {
+					t.Fatalf("v1alpha1 -> v1alpha2 -> v1alpha1 round trip lost data:\nbefore: %#v\nafter:  %#v", spoke, back)
+				}
+
+				hub = c.hub()
+				f.Fuzz(hub)
+				hub.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+				spoke = c.spoke()
+				if err := spoke.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom: %v", err)
+				}
+				hubBack := c.hub()
+				if err := spoke.ConvertTo(hubBack); err != nil {
+					t.Fatalf("ConvertTo: %v", err)
+				}
+				if !equality.Semantic.DeepEqual(hub, hubBack) {
+					t.Fatalf("v1alpha2 -> v1alpha1 -> v1alpha2 round trip lost data:\nbefore: %#v\nafter:  %#v", hub, hubBack)
+				}
+			}
+		})
+	}
+}
+
+// TestCRDsConvertWithoutWebhook checks that the CRDs in config/crd, which
+// have no conversion webhook, store this version and serve the same schema
+// in every served version. The API server converts them by rewriting
+// apiVersion, which loses every field that the versions do not share.
+func TestCRDsConvertWithoutWebhook(t *testing.T) {
+	paths, err := filepath.Glob("../../config/crd/bases/*.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("no CRDs in ../../config/crd/bases")
+	}
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var crd struct {
+			Spec struct {
+				Conversion *struct {
+					Strategy string `json:"strategy"`
+				} `json:"conversion"`
+				Versions []struct {
+					Name    string      `json:"name"`
+					Served  bool        `json:"served"`
+					Storage bool        `json:"storage"`
+					Schema  interface{} `json:"schema"`
+				} `json:"versions"`
+			} `json:"spec"`
+		}
+		if err := yaml.Unmarshal(data, &crd); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if c := crd.Spec.Conversion; c != nil && c.Strategy != "None" {
+			t.Errorf("%s: conversion strategy is %s, want None", path, c.Strategy)
+		}
+		for _, v := range crd.Spec.Versions {
+			if v.Storage != (v.Name == SchemeGroupVersion.Version) {
+				t.Errorf("%s: version %s has storage %t", path, v.Name, v.Storage)
+			}
+			if v.Served && !equality.Semantic.DeepEqual(v.Schema, crd.Spec.Versions[0].Schema) {
+				t.Errorf("%s: schema of version %s differs from %s", path, v.Name, crd.Spec.Versions[0].Name)
+			}
+		}
+	}
+}
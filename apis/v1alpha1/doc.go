@@ -17,5 +17,6 @@ limitations under the License.
 // Package v1alpha1 contains API Schema definitions for the networking.x-k8s.io
 // API group.
 // +kubebuilder:object:generate=true
+// +k8s:conversion-gen=sigs.k8s.io/service-apis/apis/v1alpha2
 // +groupName=networking.x-k8s.io
 package v1alpha1
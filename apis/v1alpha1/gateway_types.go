@@ -22,6 +22,7 @@ import (
 
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Class",type=string,JSONPath=`.spec.gatewayClassName`
 
@@ -89,8 +90,13 @@ type GatewaySpec struct {
 	// Port value but are not compatible, the GatewayClass must raise
 	// a "Conflicted" condition in the Listener status.
 	//
+	// Listeners are not keyed for server-side apply: several Listeners
+	// can share a port, and the Hostname that tells them apart is a
+	// nested struct, while list map keys must be scalar fields.
+	//
 	// Support: Core
 	//
+	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=64
 	Listeners []Listener `json:"listeners"`
@@ -374,11 +380,17 @@ type GatewayTLSConfig struct {
 	// string for both the group and the resource, the resource defaults to "secrets".
 	// An implementation may support other resources (for example, resource
 	// "mycertificates" in group "networking.acme.io").
+	//
+	// If the referent is in another namespace and no ReferenceGrant allows
+	// references from Gateways in the Gateway's namespace, the controller
+	// should set the "ResolvedRefs" condition on the Listener to false
+	// with the "InvalidCertificateRef" reason.
+	//
 	// Support: Core (Kubernetes Secrets)
 	// Support: Implementation-specific (Other resource types)
 	//
 	// +optional
-	CertificateRef LocalObjectReference `json:"certificateRef,omitempty"`
+	CertificateRef CertificateObjectReference `json:"certificateRef,omitempty"`
 
 	// RouteOverride dictates if TLS settings can be configured
 	// via Routes or not.
@@ -390,13 +402,24 @@ type GatewayTLSConfig struct {
 	// +kubebuilder:default={certificate:Deny}
 	RouteOverride TLSOverridePolicy `json:"routeOverride,omitempty"`
 
-	// Options are a list of key/value pairs to give extended options
-	// to the provider.
+	// TLSParameters define the protocol versions, cipher suites and ALPN
+	// protocols negotiated with clients.
+	//
+	// Support: Extended
+	TLSParameters `json:",inline"`
+
+	// ClientValidation defines how client certificates are requested and
+	// verified. If unspecified, client certificates are not requested.
+	// ClientValidation may only be set in Terminate mode.
+	//
+	// Support: Extended
 	//
-	// There variation among providers as to how ciphersuites are
-	// expressed. If there is a common subset for expressing ciphers
-	// then it will make sense to loft that as a core API
-	// construct.
+	// +optional
+	ClientValidation *TLSClientValidation `json:"clientValidation,omitempty"`
+
+	// Options are a list of key/value pairs to give extended options
+	// to the provider. Settings that have a typed field, such as the
+	// minimum TLS version, must not be expressed as options.
 	//
 	// Support: Implementation-specific.
 	//
@@ -404,6 +427,156 @@ type GatewayTLSConfig struct {
 	Options map[string]string `json:"options"`
 }
 
+// TLSVersion is a version of the TLS protocol.
+//
+// +kubebuilder:validation:Enum=TLSv1.0;TLSv1.1;TLSv1.2;TLSv1.3
+type TLSVersion string
+
+const (
+	// TLSVersion10 is TLS 1.0.
+	TLSVersion10 TLSVersion = "TLSv1.0"
+	// TLSVersion11 is TLS 1.1.
+	TLSVersion11 TLSVersion = "TLSv1.1"
+	// TLSVersion12 is TLS 1.2.
+	TLSVersion12 TLSVersion = "TLSv1.2"
+	// TLSVersion13 is TLS 1.3.
+	TLSVersion13 TLSVersion = "TLSv1.3"
+)
+
+// TLSParameters define the TLS protocol settings of a connection. They are
+// shared by GatewayTLSConfig and BackendTLSConfig.
+type TLSParameters struct {
+	// MinVersion is the minimum TLS version that is negotiated. If
+	// unspecified, it is implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	MinVersion *TLSVersion `json:"minVersion,omitempty"`
+
+	// MaxVersion is the maximum TLS version that is negotiated. It must not
+	// be lower than MinVersion. If unspecified, it is
+	// implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	MaxVersion *TLSVersion `json:"maxVersion,omitempty"`
+
+	// CipherSuites are the cipher suites that can be negotiated for TLS
+	// 1.2 and earlier, by IANA name, e.g.
+	// "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256". The names must be known
+	// to Go's crypto/tls package. The order of the list is not significant.
+	// TLS 1.3 cipher suites are not configurable. If unspecified, the
+	// cipher suites are implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	CipherSuites []string `json:"cipherSuites,omitempty"`
+
+	// ALPNProtocols are the application protocols offered or accepted
+	// during ALPN negotiation, in order of preference, e.g. "h2" and
+	// "http/1.1". If unspecified, the protocols are
+	// implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	ALPNProtocols []string `json:"alpnProtocols,omitempty"`
+}
+
+// ClientValidationMode defines whether clients must present a
+// certificate.
+//
+// +kubebuilder:validation:Enum=Require;Optional
+type ClientValidationMode string
+
+const (
+	// ClientValidationRequire rejects TLS handshakes in which the client
+	// does not present a valid certificate.
+	ClientValidationRequire ClientValidationMode = "Require"
+
+	// ClientValidationOptional requests a client certificate and verifies
+	// it if the client presents one, but accepts handshakes without one.
+	ClientValidationOptional ClientValidationMode = "Optional"
+)
+
+// TLSClientValidation describes the verification of client certificates
+// (mutual TLS). It is the downstream equivalent of BackendTLSConfig.
+//
+// A client certificate is accepted if it chains to a certificate of
+// CACertificateRef and, when SubjectAltNames or Subjects are set, matches
+// at least one entry of either list.
+//
+// For example, the following only accepts the "payments" and "orders"
+// service identities, and passes the identity to backends:
+//
+// ```
+// clientValidation:
+//   caCertificateRef:
+//     name: mesh-ca
+//     group: core
+//     kind: Secret
+//   subjectAltNames:
+//   - spiffe://cluster.local/ns/default/sa/payments
+//   - spiffe://cluster.local/ns/default/sa/orders
+//   identityHeader: x-client-identity
+// ```
+type TLSClientValidation struct {
+	// Mode defines whether clients must present a certificate.
+	//
+	// Support: Extended
+	//
+	// +kubebuilder:default=Require
+	Mode ClientValidationMode `json:"mode,omitempty"`
+
+	// CACertificateRef is a reference to a resource that includes the CA
+	// certificates trusted to sign client certificates. If the group and
+	// kind are empty, the resource defaults to a Secret.
+	//
+	// When stored in a Secret, certificates must be PEM encoded and
+	// specified within the "ca.crt" data field of the Secret. Multiple
+	// certificates can be specified, concatenated by new lines.
+	//
+	// Support: Extended
+	CACertificateRef LocalObjectReference `json:"caCertificateRef"`
+
+	// SubjectAltNames is an allow-list of subject alternative names. A DNS
+	// name, IP address, email address or URI of the client certificate
+	// must equal one of the entries, unless it matches Subjects.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	SubjectAltNames []string `json:"subjectAltNames,omitempty"`
+
+	// Subjects is an allow-list of client certificate subjects, as
+	// distinguished names in RFC 2253 form, e.g.
+	// "CN=payments,O=Example Corp". The subject of the client certificate
+	// must equal one of the entries, unless it matches SubjectAltNames.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	Subjects []string `json:"subjects,omitempty"`
+
+	// IdentityHeader is the name of a request header that is set to the
+	// subject of the verified client certificate before the request is
+	// forwarded. Implementations must remove any header of that name sent
+	// by the client. If unspecified, the identity is not forwarded.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	IdentityHeader *string `json:"identityHeader,omitempty"`
+}
+
 // TLSModeType type defines behavior of gateway with TLS protocol.
 // +kubebuilder:validation:Enum=Terminate;Passthrough
 // +kubebuilder:default=Terminate
@@ -813,7 +986,8 @@ const (
 
 	// ListenerReasonInvalidCertificateRef is used when the
 	// Listener has a TLS configuration with a TLS CertificateRef
-	// that is invalid or cannot be resolved.
+	// that is invalid or cannot be resolved, including a reference
+	// to another namespace that no ReferenceGrant allows.
 	ListenerReasonInvalidCertificateRef ListenerConditionReason = "InvalidCertificateRef"
 
 	// ListenerReasonInvalidRoutesRef is used when the Listener's Routes
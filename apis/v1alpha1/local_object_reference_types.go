@@ -31,3 +31,24 @@ type LocalObjectReference struct {
 	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name"`
 }
+
+// CertificateObjectReference identifies an object holding a TLS
+// certificate, optionally in another namespace than the referencing
+// object.
+type CertificateObjectReference struct {
+	LocalObjectReference `json:",inline"`
+
+	// Namespace is the namespace of the referent. If unspecified, the
+	// namespace of the referencing object is used.
+	//
+	// An object in another namespace may only be referenced if a
+	// ReferenceGrant in that namespace allows references from the kind and
+	// namespace of the referencing object.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Namespace *string `json:"namespace,omitempty"`
+}
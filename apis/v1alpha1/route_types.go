@@ -102,6 +102,24 @@ type RouteForwardTo struct {
 	// +optional
 	BackendRef *LocalObjectReference `json:"backendRef,omitempty"`
 
+	// Namespace is the namespace of the backend referenced by the
+	// ServiceName or BackendRef field. If unspecified, the namespace of
+	// the route is used.
+	//
+	// A backend in another namespace may only be referenced if a
+	// ReferenceGrant in that namespace allows references from this kind
+	// of route in the route's namespace. Otherwise, the reference must be
+	// treated as unresolved, and the controller should set the
+	// "ResolvedRefs" condition on the route to false with the
+	// "RefNotPermitted" reason.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Namespace *string `json:"namespace,omitempty"`
+
 	// Port specifies the destination port number to use for the
 	// backend referenced by the ServiceName or BackendRef field.
 	//
@@ -145,6 +163,29 @@ const (
 	// ConditionRouteAdmitted indicates whether the route has been admitted
 	// or rejected by a Gateway, and why.
 	ConditionRouteAdmitted RouteConditionType = "Admitted"
+
+	// ConditionRouteResolvedRefs indicates whether the controller was able
+	// to resolve all the object references of the route.
+	//
+	// Possible reasons for this condition to be false are:
+	//
+	// * "RefNotPermitted"
+	//
+	// Controllers may raise this condition with other reasons,
+	// but should prefer to use the reasons listed above to improve
+	// interoperability.
+	ConditionRouteResolvedRefs RouteConditionType = "ResolvedRefs"
+)
+
+// RouteConditionReason defines the set of reasons that explain why a
+// particular route condition type has been raised.
+type RouteConditionReason string
+
+const (
+	// RouteReasonRefNotPermitted is used when the route references a
+	// backend in another namespace, and no ReferenceGrant in that
+	// namespace allows the reference.
+	RouteReasonRefNotPermitted RouteConditionReason = "RefNotPermitted"
 )
 
 // RouteGatewayStatus describes the status of a route with respect to an
@@ -174,5 +215,16 @@ type RouteStatus struct {
 	// manages the Gateway should add an entry to this list when the
 	// controller first sees the route and should update the entry as
 	// appropriate when the route is modified.
+	//
+	// In this version, entries are not keyed for server-side apply, because
+	// list map keys must be scalar fields of the entry and the Gateway is
+	// identified by the nested GatewayRef. A controller that applies this
+	// list owns it as a whole. v1alpha2 keys the entries by Gateway, so
+	// controllers that share a route should apply its status in v1alpha2.
+	// The CRDs serve the v1alpha2 route kinds only when they convert with
+	// the conversion webhook; without it, controllers that share a route
+	// must update its status instead of applying it.
+	//
+	// +listType=atomic
 	Gateways []RouteGatewayStatus `json:"gateways"`
 }
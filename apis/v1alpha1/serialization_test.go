@@ -0,0 +1,35 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"sigs.k8s.io/service-apis/pkg/apitesting"
+)
+
+func TestRoundTrip(t *testing.T) {
+	apitesting.RoundTrip(t, Install)
+}
+
+func TestCompatibility(t *testing.T) {
+	apitesting.Fixtures(t, Install, "testdata")
+}
+
+func TestDefaults(t *testing.T) {
+	apitesting.Defaults(t, Install, "../../config/crd/bases")
+}
@@ -22,6 +22,7 @@ import (
 
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // TCPRoute is the Schema for the TCPRoute resource.
@@ -66,8 +67,37 @@ type TCPRouteRule struct {
 }
 
 // TCPRouteMatch defines the predicate used to match connections to a
-// given action.
+// given action. A connection is selected only if it satisfies every
+// specified condition.
+//
+// When several matches select a connection, the match with the longest
+// source CIDR prefix takes precedence, then a match that specifies a
+// Port.
 type TCPRouteMatch struct {
+	// SourceCIDRs defines a set of IPv4 or IPv6 CIDR blocks, e.g.
+	// "10.0.0.0/8" or "2001:db8::/32", that should match against the
+	// client source address of the connection. The connection matches if its
+	// source address is in any of the blocks. If unspecified, connections
+	// from every source address match.
+	//
+	// Support: core
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty"`
+
+	// Port is the destination port of the connection. If unspecified, the
+	// match accepts connections on every port of the listeners the route is
+	// bound to. A match on a port that no bound listener serves never
+	// selects a connection.
+	//
+	// Support: core
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
 	// ExtensionRef is an optional, implementation-specific extension to the
 	// "match" behavior.  The resource may be "configmap" (use the empty
 	// string for the group) or an implementation-defined resource (for
@@ -22,6 +22,7 @@ import (
 
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // TLSRoute is the Schema for the TLSRoute resource.
@@ -73,7 +74,12 @@ type TLSRouteRule struct {
 }
 
 // TLSRouteMatch defines the predicate used to match connections to a
-// given action.
+// given action. A connection is selected only if it satisfies every
+// specified condition.
+//
+// When several matches select a connection, the match with the most
+// specific SNI takes precedence, then a match that specifies a Port, then
+// a match that specifies ALPNProtocols.
 type TLSRouteMatch struct {
 	// SNIs defines a set of SNI names that should match against the
 	// SNI attribute of TLS CLientHello message in TLS handshake.
@@ -97,6 +103,32 @@ type TLSRouteMatch struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=10
 	SNIs []string `json:"snis,omitempty"`
+
+	// ALPNProtocols defines a set of application protocols, e.g. "h2" and
+	// "http/1.1", that should match against the protocols offered by the
+	// client in the ALPN extension of the TLS ClientHello message. The
+	// request matches if the client offers any of the protocols. If
+	// unspecified, the match accepts connections regardless of ALPN,
+	// including connections that offer no protocols.
+	//
+	// Support: extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	ALPNProtocols []string `json:"alpnProtocols,omitempty"`
+
+	// Port is the destination port of the connection. If unspecified,
+	// the match accepts connections on every port of the listeners the
+	// route is bound to. A match on a port that no bound listener serves
+	// never selects a connection.
+	//
+	// Support: extended
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
 	// ExtensionRef is an optional, implementation-specific extension to the
 	// "match" behavior.  The resource may be "configmap" (use the empty
 	// string for the group) or an implementation-defined resource (for
@@ -22,6 +22,7 @@ import (
 
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // UDPRoute is the Schema for the UDPRoute resource.
@@ -63,8 +64,37 @@ type UDPRouteStatus struct {
 }
 
 // UDPRouteMatch defines the predicate used to match packets to a
-// given action.
+// given action. A packet is selected only if it satisfies every
+// specified condition.
+//
+// When several matches select a packet, the match with the longest
+// source CIDR prefix takes precedence, then a match that specifies a
+// Port.
 type UDPRouteMatch struct {
+	// SourceCIDRs defines a set of IPv4 or IPv6 CIDR blocks, e.g.
+	// "10.0.0.0/8" or "2001:db8::/32", that should match against the
+	// client source address of the packet. The packet matches if its
+	// source address is in any of the blocks. If unspecified, packets
+	// from every source address match.
+	//
+	// Support: core
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty"`
+
+	// Port is the destination port of the packet. If unspecified, the
+	// match accepts packets on every port of the listeners the route is
+	// bound to. A match on a port that no bound listener serves never
+	// selects a packet.
+	//
+	// Support: core
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
 	// ExtensionRef is an optional, implementation-specific extension to the
 	// "match" behavior.  The resource may be "configmap" (use the empty
 	// string for the group) or an implementation-defined resource (for
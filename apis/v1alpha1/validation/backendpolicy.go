@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// ValidateBackendPolicy validates the constraints between the fields of a
+// BackendPolicy.
+func ValidateBackendPolicy(policy *v1alpha1.BackendPolicy) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if policy.Spec.TLS != nil {
+		errs = append(errs, validateTLSParameters(policy.Spec.TLS.TLSParameters, specPath.Child("tls"))...)
+	}
+
+	if lb := policy.Spec.LoadBalancer; lb != nil {
+		hashOnPath := specPath.Child("loadBalancer", "hashOn")
+		switch {
+		case lb.Type == v1alpha1.LoadBalancerRingHash && lb.HashOn == nil:
+			errs = append(errs, field.Required(hashOnPath, "must be set for type "+string(lb.Type)))
+		case lb.Type != v1alpha1.LoadBalancerRingHash && lb.HashOn != nil:
+			errs = append(errs, field.Forbidden(hashOnPath, "may only be set for type "+string(v1alpha1.LoadBalancerRingHash)))
+		}
+	}
+
+	if hc := policy.Spec.HealthCheck; hc != nil {
+		errs = append(errs, validateBackendHealthCheck(hc, specPath.Child("healthCheck"))...)
+	}
+
+	if sa := policy.Spec.SessionAffinity; sa != nil {
+		affinityPath := specPath.Child("sessionAffinity")
+		// An unset type is defaulted to Cookie by the API server.
+		cookieAffinity := sa.Type == "" || sa.Type == v1alpha1.SessionAffinityCookie
+		switch {
+		case cookieAffinity && sa.Cookie == nil:
+			errs = append(errs, field.Required(affinityPath.Child("cookie"), "must be set for type "+string(v1alpha1.SessionAffinityCookie)))
+		case !cookieAffinity && sa.Cookie != nil:
+			errs = append(errs, field.Forbidden(affinityPath.Child("cookie"), "may only be set for type "+string(v1alpha1.SessionAffinityCookie)))
+		}
+		if sa.Cookie != nil {
+			errs = append(errs, validatePositiveDuration(sa.Cookie.TTL, affinityPath.Child("cookie", "ttl"))...)
+		}
+	}
+
+	if cp := policy.Spec.ConnectionPool; cp != nil {
+		errs = append(errs, validatePositiveDuration(cp.IdleTimeout, specPath.Child("connectionPool", "idleTimeout"))...)
+	}
+
+	if od := policy.Spec.OutlierDetection; od != nil {
+		outlierPath := specPath.Child("outlierDetection")
+		errs = append(errs, validatePositiveDuration(od.Interval, outlierPath.Child("interval"))...)
+		errs = append(errs, validatePositiveDuration(&od.BaseEjectionTime, outlierPath.Child("baseEjectionTime"))...)
+	}
+
+	return errs
+}
+
+// validateBackendHealthCheck validates that the HTTP configuration is set
+// exactly for HTTP health checks, that the interval is positive, and that
+// the timeout is positive and shorter than the interval.
+func validateBackendHealthCheck(hc *v1alpha1.BackendHealthCheck, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	switch {
+	case hc.Type == v1alpha1.HealthCheckHTTP && hc.HTTP == nil:
+		errs = append(errs, field.Required(fldPath.Child("http"), "must be set for type "+string(hc.Type)))
+	case hc.Type != v1alpha1.HealthCheckHTTP && hc.HTTP != nil:
+		errs = append(errs, field.Forbidden(fldPath.Child("http"), "may only be set for type "+string(v1alpha1.HealthCheckHTTP)))
+	}
+
+	errs = append(errs, validatePositiveDuration(&hc.Interval, fldPath.Child("interval"))...)
+	if hc.Timeout != nil {
+		errs = append(errs, validatePositiveDuration(hc.Timeout, fldPath.Child("timeout"))...)
+		if hc.Timeout.Duration >= hc.Interval.Duration {
+			errs = append(errs, field.Invalid(fldPath.Child("timeout"), hc.Timeout.Duration.String(), "must be shorter than interval"))
+		}
+	}
+
+	return errs
+}
+
+// validatePositiveDuration validates that a duration, if set, is positive.
+// Unlike the timeouts of HTTPRoute rules, the durations of a BackendPolicy
+// have no upper limit.
+func validatePositiveDuration(d *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	if d != nil && d.Duration <= 0 {
+		return field.ErrorList{field.Invalid(fldPath, d.Duration.String(), "must be positive")}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+func TestValidateBackendPolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		spec v1alpha1.BackendPolicySpec
+		want []string
+	}{
+		{
+			name: "valid settings",
+			spec: v1alpha1.BackendPolicySpec{
+				LoadBalancer: &v1alpha1.BackendLoadBalancer{
+					Type:   v1alpha1.LoadBalancerRingHash,
+					HashOn: &v1alpha1.LoadBalancerHashKey{Type: v1alpha1.HashKeyHeader, Name: "x-user-id"},
+				},
+				HealthCheck: &v1alpha1.BackendHealthCheck{
+					Type:     v1alpha1.HealthCheckHTTP,
+					HTTP:     &v1alpha1.HTTPHealthCheck{Path: "/healthz"},
+					Interval: metav1.Duration{Duration: 10 * time.Second},
+					Timeout:  &metav1.Duration{Duration: time.Second},
+				},
+				SessionAffinity: &v1alpha1.BackendSessionAffinity{
+					Type:   v1alpha1.SessionAffinityCookie,
+					Cookie: &v1alpha1.SessionAffinityCookieConfig{Name: "backend"},
+				},
+				ConnectionPool: &v1alpha1.BackendConnectionPool{IdleTimeout: &metav1.Duration{Duration: 2 * time.Hour}},
+				OutlierDetection: &v1alpha1.BackendOutlierDetection{
+					Consecutive5xxErrors: 5,
+					BaseEjectionTime:     metav1.Duration{Duration: 2 * time.Hour},
+				},
+			},
+		},
+		{
+			name: "inconsistent settings",
+			spec: v1alpha1.BackendPolicySpec{
+				LoadBalancer: &v1alpha1.BackendLoadBalancer{
+					Type:   v1alpha1.LoadBalancerLeastRequest,
+					HashOn: &v1alpha1.LoadBalancerHashKey{Type: v1alpha1.HashKeyCookie, Name: "session"},
+				},
+				HealthCheck: &v1alpha1.BackendHealthCheck{
+					Type:     v1alpha1.HealthCheckTCP,
+					HTTP:     &v1alpha1.HTTPHealthCheck{Path: "/healthz"},
+					Interval: metav1.Duration{Duration: time.Second},
+					Timeout:  &metav1.Duration{Duration: 2 * time.Second},
+				},
+				SessionAffinity: &v1alpha1.BackendSessionAffinity{Type: v1alpha1.SessionAffinityCookie},
+				ConnectionPool:  &v1alpha1.BackendConnectionPool{IdleTimeout: &metav1.Duration{}},
+				OutlierDetection: &v1alpha1.BackendOutlierDetection{
+					Consecutive5xxErrors: 5,
+					BaseEjectionTime:     metav1.Duration{Duration: -time.Minute},
+				},
+			},
+			want: []string{
+				"spec.loadBalancer.hashOn",
+				"spec.healthCheck.http",
+				"spec.healthCheck.timeout",
+				"spec.sessionAffinity.cookie",
+				"spec.connectionPool.idleTimeout",
+				"spec.outlierDetection.baseEjectionTime",
+			},
+		},
+		{
+			name: "defaulted types",
+			spec: v1alpha1.BackendPolicySpec{
+				LoadBalancer: &v1alpha1.BackendLoadBalancer{
+					HashOn: &v1alpha1.LoadBalancerHashKey{Type: v1alpha1.HashKeyCookie, Name: "session"},
+				},
+				SessionAffinity: &v1alpha1.BackendSessionAffinity{},
+			},
+			want: []string{
+				"spec.loadBalancer.hashOn",
+				"spec.sessionAffinity.cookie",
+			},
+		},
+		{
+			name: "cookie for another session affinity type",
+			spec: v1alpha1.BackendPolicySpec{
+				SessionAffinity: &v1alpha1.BackendSessionAffinity{
+					Type:   "SourceIP",
+					Cookie: &v1alpha1.SessionAffinityCookieConfig{Name: "backend"},
+				},
+			},
+			want: []string{"spec.sessionAffinity.cookie"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateBackendPolicy(&v1alpha1.BackendPolicy{Spec: tc.spec})
+
+			if len(errs) != len(tc.want) {
+				t.Fatalf("got errors %v, want errors for %v", errs, tc.want)
+			}
+			for i, err := range errs {
+				if err.Field != tc.want[i] {
+					t.Errorf("error %d: got field %s, want %s", i, err.Field, tc.want[i])
+				}
+			}
+		})
+	}
+}
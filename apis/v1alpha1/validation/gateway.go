@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"strings"
+
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/tlsconfig"
+)
+
+// ValidateGateway validates the constraints between the fields of a
+// Gateway.
+func ValidateGateway(gateway *v1alpha1.Gateway) field.ErrorList {
+	var errs field.ErrorList
+	listenersPath := field.NewPath("spec", "listeners")
+
+	for i, l := range gateway.Spec.Listeners {
+		if l.TLS != nil {
+			tlsPath := listenersPath.Index(i).Child("tls")
+			errs = append(errs, validateTLSParameters(l.TLS.TLSParameters, tlsPath)...)
+			if l.TLS.ClientValidation != nil {
+				errs = append(errs, validateTLSClientValidation(l.TLS, tlsPath.Child("clientValidation"))...)
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateTLSParameters validates that the cipher suites are known to
+// crypto/tls and are not TLS 1.3 cipher suites, that the minimum version is not higher than the maximum
+// version, and that no ALPN protocol is listed twice.
+func validateTLSParameters(params v1alpha1.TLSParameters, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if params.MinVersion != nil && params.MaxVersion != nil {
+		min, minErr := tlsconfig.Version(*params.MinVersion)
+		max, maxErr := tlsconfig.Version(*params.MaxVersion)
+		if minErr == nil && maxErr == nil && min > max {
+			errs = append(errs, field.Invalid(fldPath.Child("maxVersion"), *params.MaxVersion,
+				"must not be lower than minVersion"))
+		}
+	}
+
+	for i, name := range params.CipherSuites {
+		if _, err := tlsconfig.CipherSuite(name); errors.Is(err, tlsconfig.ErrTLS13CipherSuite) {
+			errs = append(errs, field.Invalid(fldPath.Child("cipherSuites").Index(i), name, tlsconfig.ErrTLS13CipherSuite.Error()))
+		} else if err != nil {
+			errs = append(errs, field.NotSupported(fldPath.Child("cipherSuites").Index(i), name, nil))
+		}
+	}
+
+	seen := map[string]bool{}
+	for i, proto := range params.ALPNProtocols {
+		if seen[proto] {
+			errs = append(errs, field.Duplicate(fldPath.Child("alpnProtocols").Index(i), proto))
+		}
+		seen[proto] = true
+	}
+
+	return errs
+}
+
+// validateTLSClientValidation validates that client certificates are only
+// validated on terminated TLS connections, and that the identity header is
+// a valid header name.
+func validateTLSClientValidation(tls *v1alpha1.GatewayTLSConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if tls.Mode == v1alpha1.TLSModePassthrough {
+		errs = append(errs, field.Forbidden(fldPath, "must not be set for mode "+string(tls.Mode)))
+	}
+
+	if h := tls.ClientValidation.IdentityHeader; h != nil {
+		for _, msg := range utilvalidation.IsHTTPHeaderName(*h) {
+			errs = append(errs, field.Invalid(fldPath.Child("identityHeader"), *h, msg))
+		}
+		if strings.EqualFold(*h, "Host") {
+			errs = append(errs, field.Invalid(fldPath.Child("identityHeader"), *h, "must not be the Host header"))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+func TestValidateGateway(t *testing.T) {
+	v12, v13 := v1alpha1.TLSVersion12, v1alpha1.TLSVersion13
+
+	header := "x client"
+
+	tests := []struct {
+		name       string
+		mode       v1alpha1.TLSModeType
+		tls        v1alpha1.TLSParameters
+		validation *v1alpha1.TLSClientValidation
+		want       []string
+	}{
+		{
+			name: "valid TLS parameters",
+			tls: v1alpha1.TLSParameters{
+				MinVersion:    &v12,
+				MaxVersion:    &v13,
+				CipherSuites:  []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+				ALPNProtocols: []string{"h2", "http/1.1"},
+			},
+		},
+		{
+			name: "invalid TLS parameters",
+			tls: v1alpha1.TLSParameters{
+				MinVersion:    &v13,
+				MaxVersion:    &v12,
+				CipherSuites:  []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384", "ECDHE-RSA-AES256-GCM-SHA384"},
+				ALPNProtocols: []string{"h2", "h2"},
+			},
+			want: []string{
+				"spec.listeners[0].tls.maxVersion",
+				"spec.listeners[0].tls.cipherSuites[1]",
+				"spec.listeners[0].tls.alpnProtocols[1]",
+			},
+		},
+		{
+			name: "TLS 1.3 cipher suite",
+			tls: v1alpha1.TLSParameters{
+				CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384", "TLS_AES_128_GCM_SHA256"},
+			},
+			want: []string{"spec.listeners[0].tls.cipherSuites[1]"},
+		},
+		{
+			name:       "client validation with passthrough",
+			mode:       v1alpha1.TLSModePassthrough,
+			validation: &v1alpha1.TLSClientValidation{IdentityHeader: &header},
+			want: []string{
+				"spec.listeners[0].tls.clientValidation",
+				"spec.listeners[0].tls.clientValidation.identityHeader",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &v1alpha1.Gateway{Spec: v1alpha1.GatewaySpec{
+				Listeners: []v1alpha1.Listener{{
+					Protocol: v1alpha1.HTTPSProtocolType,
+					Port:     443,
+					TLS: &v1alpha1.GatewayTLSConfig{
+						Mode:             tc.mode,
+						TLSParameters:    tc.tls,
+						ClientValidation: tc.validation,
+					},
+				}},
+			}}
+			errs := ValidateGateway(gateway)
+
+			if len(errs) != len(tc.want) {
+				t.Fatalf("got errors %v, want errors for %v", errs, tc.want)
+			}
+			for i, err := range errs {
+				if err.Field != tc.want[i] {
+					t.Errorf("error %d: got field %s, want %s", i, err.Field, tc.want[i])
+				}
+			}
+		})
+	}
+}
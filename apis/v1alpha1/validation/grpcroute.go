@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// ValidateGRPCRoute validates the constraints between the fields of a
+// GRPCRoute.
+func ValidateGRPCRoute(route *v1alpha1.GRPCRoute) field.ErrorList {
+	var errs field.ErrorList
+	rulesPath := field.NewPath("spec", "rules")
+
+	for i, rule := range route.Spec.Rules {
+		for j, m := range rule.Matches {
+			matchPath := rulesPath.Index(i).Child("matches").Index(j)
+			if m.Method != nil {
+				errs = append(errs, validateGRPCMethodMatch(*m.Method, matchPath.Child("method"))...)
+			}
+			for k, h := range m.Headers {
+				errs = append(errs, validateHTTPHeaderMatcher(h, matchPath.Child("headers").Index(k))...)
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateGRPCMethodMatch validates that a method match sets a service or
+// a method, and that exact names do not contain the "/" separator of gRPC
+// request paths.
+func validateGRPCMethodMatch(m v1alpha1.GRPCMethodMatch, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if m.Service == nil && m.Method == nil {
+		errs = append(errs, field.Required(fldPath, "one or both of service or method must be specified"))
+	}
+
+	if m.Type == "" || m.Type == v1alpha1.GRPCMethodMatchExact {
+		if m.Service != nil && (*m.Service == "" || strings.Contains(*m.Service, "/")) {
+			errs = append(errs, field.Invalid(fldPath.Child("service"), *m.Service, "must be a non-empty name without \"/\""))
+		}
+		if m.Method != nil && (*m.Method == "" || strings.Contains(*m.Method, "/")) {
+			errs = append(errs, field.Invalid(fldPath.Child("method"), *m.Method, "must be a non-empty name without \"/\""))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+func TestValidateGRPCRoute(t *testing.T) {
+	service := "foo.bar.Greeter"
+	path := "/foo.bar.Greeter/SayHello"
+	pattern := "foo\\..*/Say.*"
+
+	tests := []struct {
+		name    string
+		matches []v1alpha1.GRPCRouteMatch
+		want    []string
+	}{
+		{
+			name: "service and headers",
+			matches: []v1alpha1.GRPCRouteMatch{{
+				Method:  &v1alpha1.GRPCMethodMatch{Service: &service},
+				Headers: []v1alpha1.HTTPHeaderMatcher{{Name: "version", Value: "2"}},
+			}, {
+				Method: &v1alpha1.GRPCMethodMatch{Type: v1alpha1.GRPCMethodMatchRegularExpression, Service: &pattern},
+			}},
+		},
+		{
+			name: "invalid method matches",
+			matches: []v1alpha1.GRPCRouteMatch{{
+				Method:  &v1alpha1.GRPCMethodMatch{},
+				Headers: []v1alpha1.HTTPHeaderMatcher{{Name: "version", Type: v1alpha1.HeaderMatchPresent, Value: "2"}},
+			}, {
+				Method: &v1alpha1.GRPCMethodMatch{Service: &path},
+			}},
+			want: []string{
+				"spec.rules[0].matches[0].method",
+				"spec.rules[0].matches[0].headers[0].value",
+				"spec.rules[0].matches[1].method.service",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			route := &v1alpha1.GRPCRoute{Spec: v1alpha1.GRPCRouteSpec{
+				Rules: []v1alpha1.GRPCRouteRule{{Matches: tc.matches}},
+			}}
+			errs := ValidateGRPCRoute(route)
+
+			if len(errs) != len(tc.want) {
+				t.Fatalf("got errors %v, want errors for %v", errs, tc.want)
+			}
+			for i, err := range errs {
+				if err.Field != tc.want[i] {
+					t.Errorf("error %d: got field %s, want %s", i, err.Field, tc.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation validates the constraints of service-apis objects
+// that the CRD schema cannot express, such as constraints between fields.
+// Implementations should reject objects that fail validation.
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// ValidateHTTPRoute validates the constraints between the fields of an
+// HTTPRoute.
+func ValidateHTTPRoute(route *v1alpha1.HTTPRoute) field.ErrorList {
+	var errs field.ErrorList
+	rulesPath := field.NewPath("spec", "rules")
+
+	for i, rule := range route.Spec.Rules {
+		rulePath := rulesPath.Index(i)
+		for j, m := range rule.Matches {
+			if m.Headers != nil {
+				errs = append(errs, validateHTTPHeaderMatch(*m.Headers, rulePath.Child("matches").Index(j).Child("headers"))...)
+			}
+		}
+		errs = append(errs, validateHTTPRouteFilters(rule.Filters, rule.Matches, rulePath.Child("filters"))...)
+		if hasRequestRedirect(rule.Filters) && len(rule.ForwardTo) > 0 {
+			errs = append(errs, field.Forbidden(rulePath.Child("forwardTo"), "must not be set when the rule has a "+v1alpha1.FilterTypeHTTPRequestRedirect+" filter"))
+		}
+		for j, f := range rule.ForwardTo {
+			filtersPath := rulePath.Child("forwardTo").Index(j).Child("filters")
+			errs = append(errs, validateHTTPRouteFilters(f.Filters, rule.Matches, filtersPath)...)
+			for k, filter := range f.Filters {
+				if filter.Type == v1alpha1.FilterTypeHTTPRequestRedirect {
+					errs = append(errs, field.Forbidden(filtersPath.Index(k).Child("type"), filter.Type+" may only be used in the filters of a rule"))
+				}
+			}
+		}
+		errs = append(errs, validateHTTPRouteTimeouts(rule.Timeouts, rule.Retry, rulePath)...)
+	}
+
+	return errs
+}
+
+// validateHTTPHeaderMatch validates that a header match has at least one
+// condition, that the Values map is not used with match types that take
+// no value, and that each matcher has a value exactly when its type takes
+// one.
+func validateHTTPHeaderMatch(match v1alpha1.HTTPHeaderMatch, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if len(match.Values) == 0 && len(match.Matchers) == 0 {
+		errs = append(errs, field.Required(fldPath, "values or matchers must contain at least one entry"))
+	}
+
+	switch match.Type {
+	case v1alpha1.HeaderMatchPresent, v1alpha1.HeaderMatchAbsent:
+		if len(match.Values) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child("type"), match.Type, "cannot be used with values; use matchers instead"))
+		}
+	}
+
+	for i, m := range match.Matchers {
+		errs = append(errs, validateHTTPHeaderMatcher(m, fldPath.Child("matchers").Index(i))...)
+	}
+
+	return errs
+}
+
+// validateHTTPHeaderMatcher validates that a matcher has a value exactly
+// when its type takes one.
+func validateHTTPHeaderMatcher(m v1alpha1.HTTPHeaderMatcher, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	switch m.Type {
+	case "", v1alpha1.HeaderMatchExact, v1alpha1.HeaderMatchRegularExpression:
+		if m.Value == "" {
+			errs = append(errs, field.Required(fldPath.Child("value"), "must be set for type "+string(headerMatchType(m.Type))))
+		}
+	case v1alpha1.HeaderMatchPresent, v1alpha1.HeaderMatchAbsent:
+		if m.Value != "" {
+			errs = append(errs, field.Forbidden(fldPath.Child("value"), "must not be set for type "+string(m.Type)))
+		}
+	}
+
+	return errs
+}
+
+func headerMatchType(t v1alpha1.HeaderMatchType) v1alpha1.HeaderMatchType {
+	if t == "" {
+		return v1alpha1.HeaderMatchExact
+	}
+	return t
+}
+
+// hasRequestRedirect reports whether any of the filters redirects requests.
+func hasRequestRedirect(filters []v1alpha1.HTTPRouteFilter) bool {
+	for _, f := range filters {
+		if f.Type == v1alpha1.FilterTypeHTTPRequestRedirect {
+			return true
+		}
+	}
+	return false
+}
+
+// validateHTTPRouteFilters validates that each filter sets exactly the
+// configuration field of its type, and the configuration of each filter
+// against the matches of the rule the filters apply to.
+func validateHTTPRouteFilters(filters []v1alpha1.HTTPRouteFilter, matches []v1alpha1.HTTPRouteMatch, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, f := range filters {
+		filterPath := fldPath.Index(i)
+
+		configs := []struct {
+			filterType string
+			name       string
+			set        bool
+		}{
+			{v1alpha1.FilterTypeHTTPRequestHeader, "requestHeader", f.RequestHeader != nil},
+			{v1alpha1.FilterTypeHTTPResponseHeader, "responseHeader", f.ResponseHeader != nil},
+			{v1alpha1.FilterTypeHTTPRequestMirror, "requestMirror", f.RequestMirror != nil},
+			{v1alpha1.FilterTypeHTTPRequestRedirect, "requestRedirect", f.RequestRedirect != nil},
+			{v1alpha1.FilterTypeURLRewrite, "urlRewrite", f.URLRewrite != nil},
+		}
+
+		core := false
+		for _, c := range configs {
+			switch {
+			case c.filterType == f.Type:
+				core = true
+				if !c.set {
+					errs = append(errs, field.Required(filterPath.Child(c.name), "must be set for filter type "+f.Type))
+				}
+			case c.set:
+				errs = append(errs, field.Forbidden(filterPath.Child(c.name), "may only be set for filter type "+c.filterType))
+			}
+		}
+		if core && f.ExtensionRef != nil {
+			errs = append(errs, field.Forbidden(filterPath.Child("extensionRef"), "must not be set for filter type "+f.Type))
+		}
+
+		if h := f.RequestHeader; h != nil {
+			errs = append(errs, validateHeaderOperations(h.Set, h.Add, h.Remove, filterPath.Child("requestHeader"))...)
+		}
+		if h := f.ResponseHeader; h != nil {
+			errs = append(errs, validateHeaderOperations(h.Set, h.Add, h.Remove, filterPath.Child("responseHeader"))...)
+		}
+		if f.URLRewrite != nil && f.URLRewrite.Path != nil {
+			errs = append(errs, validateHTTPPathModifier(*f.URLRewrite.Path, matches, filterPath.Child("urlRewrite", "path"))...)
+		}
+	}
+
+	return errs
+}
+
+// validateHeaderOperations validates that each header is modified by at
+// most one operation of a header filter. Header names are compared
+// case-insensitively.
+func validateHeaderOperations(set, add map[string]string, remove []string, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	seen := map[string]string{}
+
+	check := func(name, op string) {
+		key := strings.ToLower(name)
+		if prev, ok := seen[key]; ok {
+			errs = append(errs, field.Invalid(fldPath.Child(op), name,
+				fmt.Sprintf("header is also modified by %s", prev)))
+			return
+		}
+		seen[key] = op
+	}
+
+	for _, name := range sortedKeys(set) {
+		check(name, "set")
+	}
+	for _, name := range sortedKeys(add) {
+		check(name, "add")
+	}
+	for _, name := range remove {
+		check(name, "remove")
+	}
+
+	return errs
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validateHTTPPathModifier validates that the field selected by the
+// modifier type is the only one set, and that prefix replacement is only
+// used in rules whose matches are all Prefix path matches.
+func validateHTTPPathModifier(modifier v1alpha1.HTTPPathModifier, matches []v1alpha1.HTTPRouteMatch, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	switch modifier.Type {
+	case v1alpha1.FullPathHTTPPathModifier:
+		if modifier.ReplaceFullPath == nil {
+			errs = append(errs, field.Required(fldPath.Child("replaceFullPath"), "must be set for type "+string(modifier.Type)))
+		}
+		if modifier.ReplacePrefixMatch != nil {
+			errs = append(errs, field.Forbidden(fldPath.Child("replacePrefixMatch"), "must not be set for type "+string(modifier.Type)))
+		}
+	case v1alpha1.PrefixMatchHTTPPathModifier:
+		if modifier.ReplacePrefixMatch == nil {
+			errs = append(errs, field.Required(fldPath.Child("replacePrefixMatch"), "must be set for type "+string(modifier.Type)))
+		}
+		if modifier.ReplaceFullPath != nil {
+			errs = append(errs, field.Forbidden(fldPath.Child("replaceFullPath"), "must not be set for type "+string(modifier.Type)))
+		}
+		for _, m := range matches {
+			if m.Path.Type != "" && m.Path.Type != v1alpha1.PathMatchPrefix {
+				errs = append(errs, field.Invalid(fldPath.Child("type"), modifier.Type,
+					"can only be used in rules whose path matches are all of type Prefix"))
+				break
+			}
+		}
+	}
+
+	return errs
+}
+
+// maxHTTPTimeout is the longest timeout or backoff interval that can be
+// configured on an HTTPRoute rule.
+const maxHTTPTimeout = time.Hour
+
+// validateHTTPRouteTimeouts validates that the timeouts and retry policy of
+// a rule are within limits, that the request timeout is not shorter than
+// the backend request timeout, that each attempt fits within both, and
+// that the backoff interval does not shrink.
+func validateHTTPRouteTimeouts(timeouts *v1alpha1.HTTPRouteTimeouts, retry *v1alpha1.HTTPRetryPolicy, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	var request, backendRequest *metav1.Duration
+
+	if timeouts != nil {
+		timeoutsPath := fldPath.Child("timeouts")
+		request, backendRequest = timeouts.Request, timeouts.BackendRequest
+		errs = append(errs, validateDuration(request, timeoutsPath.Child("request"))...)
+		errs = append(errs, validateDuration(backendRequest, timeoutsPath.Child("backendRequest"))...)
+		if request != nil && backendRequest != nil && backendRequest.Duration > request.Duration {
+			errs = append(errs, field.Invalid(timeoutsPath.Child("backendRequest"), backendRequest.Duration.String(),
+				"must not be longer than the request timeout"))
+		}
+	}
+
+	if retry == nil {
+		return errs
+	}
+	retryPath := fldPath.Child("retry")
+
+	if len(retry.RetryOn.StatusCodes) == 0 && !retry.RetryOn.ConnectFailure {
+		errs = append(errs, field.Required(retryPath.Child("retryOn"), "must specify at least one condition"))
+	}
+
+	if t := retry.PerTryTimeout; t != nil {
+		tryPath := retryPath.Child("perTryTimeout")
+		errs = append(errs, validateDuration(t, tryPath)...)
+		if request != nil && t.Duration > request.Duration {
+			errs = append(errs, field.Invalid(tryPath, t.Duration.String(), "must not be longer than the request timeout"))
+		} else if backendRequest != nil && t.Duration > backendRequest.Duration {
+			errs = append(errs, field.Invalid(tryPath, t.Duration.String(), "must not be longer than the backend request timeout"))
+		}
+	}
+
+	if b := retry.Backoff; b != nil {
+		backoffPath := retryPath.Child("backoff")
+		errs = append(errs, validateDuration(&b.BaseInterval, backoffPath.Child("baseInterval"))...)
+		errs = append(errs, validateDuration(b.MaxInterval, backoffPath.Child("maxInterval"))...)
+		if b.MaxInterval != nil && b.MaxInterval.Duration < b.BaseInterval.Duration {
+			errs = append(errs, field.Invalid(backoffPath.Child("maxInterval"), b.MaxInterval.Duration.String(),
+				"must not be shorter than baseInterval"))
+		}
+	}
+
+	return errs
+}
+
+// validateDuration validates that a duration, if set, is positive and does
+// not exceed maxHTTPTimeout.
+func validateDuration(d *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	if d == nil {
+		return nil
+	}
+	if d.Duration <= 0 || d.Duration > maxHTTPTimeout {
+		return field.ErrorList{field.Invalid(fldPath, d.Duration.String(),
+			fmt.Sprintf("must be positive and at most %s", maxHTTPTimeout))}
+	}
+	return nil
+}
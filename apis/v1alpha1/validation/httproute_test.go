@@ -0,0 +1,214 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+func TestValidateHTTPRoute(t *testing.T) {
+	prefix := "/"
+	fullPath := "/orders"
+	duration := func(d time.Duration) *metav1.Duration {
+		return &metav1.Duration{Duration: d}
+	}
+
+	service := "web"
+	redirect := v1alpha1.HTTPRouteFilter{
+		Type:            v1alpha1.FilterTypeHTTPRequestRedirect,
+		RequestRedirect: &v1alpha1.HTTPRequestRedirectFilter{},
+	}
+
+	rewrite := func(modifier v1alpha1.HTTPPathModifier) []v1alpha1.HTTPRouteFilter {
+		return []v1alpha1.HTTPRouteFilter{{
+			Type:       v1alpha1.FilterTypeURLRewrite,
+			URLRewrite: &v1alpha1.HTTPURLRewriteFilter{Path: &modifier},
+		}}
+	}
+
+	tests := []struct {
+		name  string
+		rules []v1alpha1.HTTPRouteRule
+		want  []string
+	}{
+		{
+			name: "prefix replacement with prefix match",
+			rules: []v1alpha1.HTTPRouteRule{{
+				Matches: []v1alpha1.HTTPRouteMatch{{Path: v1alpha1.HTTPPathMatch{Type: v1alpha1.PathMatchPrefix, Value: "/api"}}},
+				Filters: rewrite(v1alpha1.HTTPPathModifier{Type: v1alpha1.PrefixMatchHTTPPathModifier, ReplacePrefixMatch: &prefix}),
+			}},
+		},
+		{
+			name: "prefix replacement without matches",
+			rules: []v1alpha1.HTTPRouteRule{{
+				Filters: rewrite(v1alpha1.HTTPPathModifier{Type: v1alpha1.PrefixMatchHTTPPathModifier, ReplacePrefixMatch: &prefix}),
+			}},
+		},
+		{
+			name: "prefix replacement with exact match",
+			rules: []v1alpha1.HTTPRouteRule{{
+				Matches: []v1alpha1.HTTPRouteMatch{{Path: v1alpha1.HTTPPathMatch{Type: v1alpha1.PathMatchExact, Value: "/api"}}},
+				ForwardTo: []v1alpha1.HTTPRouteForwardTo{{
+					Filters: rewrite(v1alpha1.HTTPPathModifier{Type: v1alpha1.PrefixMatchHTTPPathModifier, ReplacePrefixMatch: &prefix}),
+				}},
+			}},
+			want: []string{"spec.rules[0].forwardTo[0].filters[0].urlRewrite.path.type"},
+		},
+		{
+			name: "full path replacement with the wrong field",
+			rules: []v1alpha1.HTTPRouteRule{{
+				Filters: rewrite(v1alpha1.HTTPPathModifier{Type: v1alpha1.FullPathHTTPPathModifier, ReplacePrefixMatch: &fullPath}),
+			}},
+			want: []string{
+				"spec.rules[0].filters[0].urlRewrite.path.replaceFullPath",
+				"spec.rules[0].filters[0].urlRewrite.path.replacePrefixMatch",
+			},
+		},
+		{
+			name: "filter configuration does not match type",
+			rules: []v1alpha1.HTTPRouteRule{{
+				Filters: []v1alpha1.HTTPRouteFilter{{
+					Type:            v1alpha1.FilterTypeHTTPRequestRedirect,
+					URLRewrite:      &v1alpha1.HTTPURLRewriteFilter{},
+					RequestRedirect: nil,
+				}},
+			}},
+			want: []string{
+				"spec.rules[0].filters[0].requestRedirect",
+				"spec.rules[0].filters[0].urlRewrite",
+			},
+		},
+		{
+			name: "redirect without forwardTo",
+			rules: []v1alpha1.HTTPRouteRule{{
+				Filters: []v1alpha1.HTTPRouteFilter{redirect},
+			}},
+		},
+		{
+			name: "redirect with forwardTo",
+			rules: []v1alpha1.HTTPRouteRule{{
+				Filters:   []v1alpha1.HTTPRouteFilter{redirect},
+				ForwardTo: []v1alpha1.HTTPRouteForwardTo{{ServiceName: &service}},
+			}},
+			want: []string{"spec.rules[0].forwardTo"},
+		},
+		{
+			name: "redirect as a forwardTo filter",
+			rules: []v1alpha1.HTTPRouteRule{{
+				ForwardTo: []v1alpha1.HTTPRouteForwardTo{{
+					ServiceName: &service,
+					Filters:     []v1alpha1.HTTPRouteFilter{redirect},
+				}},
+			}},
+			want: []string{"spec.rules[0].forwardTo[0].filters[0].type"},
+		},
+		{
+			name: "header modified by several operations",
+			rules: []v1alpha1.HTTPRouteRule{{
+				Filters: []v1alpha1.HTTPRouteFilter{{
+					Type: v1alpha1.FilterTypeHTTPResponseHeader,
+					ResponseHeader: &v1alpha1.HTTPResponseHeaderFilter{
+						Set:    map[string]string{"Server": "gateway"},
+						Remove: []string{"server", "x-powered-by"},
+					},
+				}},
+			}},
+			want: []string{"spec.rules[0].filters[0].responseHeader.remove"},
+		},
+		{
+			name: "header matchers",
+			rules: []v1alpha1.HTTPRouteRule{{
+				Matches: []v1alpha1.HTTPRouteMatch{{
+					Headers: &v1alpha1.HTTPHeaderMatch{
+						Matchers: []v1alpha1.HTTPHeaderMatcher{
+							{Name: "cookie", Type: v1alpha1.HeaderMatchRegularExpression, Value: ".*canary=true.*"},
+							{Name: "x-debug", Type: v1alpha1.HeaderMatchAbsent, Value: "1"},
+							{Name: "x-version"},
+						},
+					},
+				}, {
+					Headers: &v1alpha1.HTTPHeaderMatch{
+						Type:   v1alpha1.HeaderMatchPresent,
+						Values: map[string]string{"x-debug": ""},
+					},
+				}},
+			}},
+			want: []string{
+				"spec.rules[0].matches[0].headers.matchers[1].value",
+				"spec.rules[0].matches[0].headers.matchers[2].value",
+				"spec.rules[0].matches[1].headers.type",
+			},
+		},
+		{
+			name: "timeouts and retries within limits",
+			rules: []v1alpha1.HTTPRouteRule{{
+				Timeouts: &v1alpha1.HTTPRouteTimeouts{Request: duration(10 * time.Second), BackendRequest: duration(5 * time.Second)},
+				Retry: &v1alpha1.HTTPRetryPolicy{
+					Attempts:      2,
+					PerTryTimeout: duration(time.Second),
+					RetryOn:       v1alpha1.HTTPRetryOn{StatusCodes: []v1alpha1.HTTPRetryStatusCode{503}},
+					Backoff:       &v1alpha1.HTTPRetryBackoff{BaseInterval: metav1.Duration{Duration: 25 * time.Millisecond}},
+				},
+			}},
+		},
+		{
+			name: "timeouts and retries out of limits",
+			rules: []v1alpha1.HTTPRouteRule{{
+				Timeouts: &v1alpha1.HTTPRouteTimeouts{Request: duration(2 * time.Hour), BackendRequest: duration(3 * time.Hour)},
+			}, {
+				Timeouts: &v1alpha1.HTTPRouteTimeouts{BackendRequest: duration(time.Second)},
+				Retry: &v1alpha1.HTTPRetryPolicy{
+					Attempts:      2,
+					PerTryTimeout: duration(2 * time.Second),
+					Backoff: &v1alpha1.HTTPRetryBackoff{
+						BaseInterval: metav1.Duration{Duration: time.Second},
+						MaxInterval:  duration(100 * time.Millisecond),
+					},
+				},
+			}},
+			want: []string{
+				"spec.rules[0].timeouts.request",
+				"spec.rules[0].timeouts.backendRequest",
+				"spec.rules[0].timeouts.backendRequest",
+				"spec.rules[1].retry.retryOn",
+				"spec.rules[1].retry.perTryTimeout",
+				"spec.rules[1].retry.backoff.maxInterval",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			route := &v1alpha1.HTTPRoute{Spec: v1alpha1.HTTPRouteSpec{Rules: tc.rules}}
+			errs := ValidateHTTPRoute(route)
+
+			if len(errs) != len(tc.want) {
+				t.Fatalf("got errors %v, want errors for %v", errs, tc.want)
+			}
+			for i, err := range errs {
+				if err.Field != tc.want[i] {
+					t.Errorf("error %d: got field %s, want %s", i, err.Field, tc.want[i])
+				}
+			}
+		})
+	}
+}
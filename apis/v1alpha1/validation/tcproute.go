@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// ValidateTCPRoute validates the constraints between the fields of a
+// TCPRoute.
+func ValidateTCPRoute(route *v1alpha1.TCPRoute) field.ErrorList {
+	var errs field.ErrorList
+	rulesPath := field.NewPath("spec", "rules")
+
+	for i, rule := range route.Spec.Rules {
+		for j, m := range rule.Matches {
+			matchPath := rulesPath.Index(i).Child("matches").Index(j)
+			errs = append(errs, validateSourceCIDRs(m.SourceCIDRs, matchPath.Child("sourceCIDRs"))...)
+		}
+	}
+
+	return errs
+}
+
+// validateSourceCIDRs validates that each source CIDR block is written
+// with its network address, e.g. "10.0.0.0/8" rather than "10.1.2.3/8",
+// and is not listed twice.
+func validateSourceCIDRs(cidrs []string, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	seen := map[string]bool{}
+	for i, cidr := range cidrs {
+		ip, network, err := net.ParseCIDR(cidr)
+		switch {
+		case err != nil:
+			errs = append(errs, field.Invalid(fldPath.Index(i), cidr, "must be an IPv4 or IPv6 CIDR block, e.g. 10.0.0.0/8"))
+		case !ip.Equal(network.IP):
+			errs = append(errs, field.Invalid(fldPath.Index(i), cidr, "must be written with its network address "+network.String()))
+		case seen[network.String()]:
+			errs = append(errs, field.Duplicate(fldPath.Index(i), cidr))
+		}
+		if err == nil {
+			seen[network.String()] = true
+		}
+	}
+
+	return errs
+}
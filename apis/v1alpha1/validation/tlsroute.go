@@ -0,0 +1,49 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// ValidateTLSRoute validates the constraints between the fields of a
+// TLSRoute.
+func ValidateTLSRoute(route *v1alpha1.TLSRoute) field.ErrorList {
+	var errs field.ErrorList
+	rulesPath := field.NewPath("spec", "rules")
+
+	for i, rule := range route.Spec.Rules {
+		for j, m := range rule.Matches {
+			matchPath := rulesPath.Index(i).Child("matches").Index(j)
+			seen := map[string]bool{}
+			for k, proto := range m.ALPNProtocols {
+				protoPath := matchPath.Child("alpnProtocols").Index(k)
+				switch {
+				case proto == "" || len(proto) > 255:
+					errs = append(errs, field.Invalid(protoPath, proto, "must be between 1 and 255 bytes long"))
+				case seen[proto]:
+					errs = append(errs, field.Duplicate(protoPath, proto))
+				}
+				seen[proto] = true
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+func TestValidateTLSRoute(t *testing.T) {
+	tests := []struct {
+		name    string
+		matches []v1alpha1.TLSRouteMatch
+		want    []string
+	}{
+		{
+			name: "SNI and ALPN protocols",
+			matches: []v1alpha1.TLSRouteMatch{{
+				SNIs:          []string{"foo.example.com"},
+				ALPNProtocols: []string{"h2", "http/1.1"},
+			}},
+		},
+		{
+			name: "invalid ALPN protocols",
+			matches: []v1alpha1.TLSRouteMatch{{
+				ALPNProtocols: []string{"h2", "h2"},
+			}, {
+				ALPNProtocols: []string{""},
+			}},
+			want: []string{
+				"spec.rules[0].matches[0].alpnProtocols[1]",
+				"spec.rules[0].matches[1].alpnProtocols[0]",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			route := &v1alpha1.TLSRoute{Spec: v1alpha1.TLSRouteSpec{
+				Rules: []v1alpha1.TLSRouteRule{{Matches: tc.matches}},
+			}}
+			errs := ValidateTLSRoute(route)
+
+			if len(errs) != len(tc.want) {
+				t.Fatalf("got errors %v, want errors for %v", errs, tc.want)
+			}
+			for i, err := range errs {
+				if err.Field != tc.want[i] {
+					t.Errorf("error %d: got field %s, want %s", i, err.Field, tc.want[i])
+				}
+			}
+		})
+	}
+}
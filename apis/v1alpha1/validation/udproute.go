@@ -0,0 +1,39 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// ValidateUDPRoute validates the constraints between the fields of a
+// UDPRoute.
+func ValidateUDPRoute(route *v1alpha1.UDPRoute) field.ErrorList {
+	var errs field.ErrorList
+	rulesPath := field.NewPath("spec", "rules")
+
+	for i, rule := range route.Spec.Rules {
+		for j, m := range rule.Matches {
+			matchPath := rulesPath.Index(i).Child("matches").Index(j)
+			errs = append(errs, validateSourceCIDRs(m.SourceCIDRs, matchPath.Child("sourceCIDRs"))...)
+		}
+	}
+
+	return errs
+}
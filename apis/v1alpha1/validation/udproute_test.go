@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+func TestValidateUDPRoute(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []v1alpha1.UDPRouteRule
+		want  []string
+	}{
+		{
+			name: "IPv4 and IPv6 blocks",
+			rules: []v1alpha1.UDPRouteRule{{
+				Matches: []v1alpha1.UDPRouteMatch{{
+					SourceCIDRs: []string{"10.0.0.0/8", "192.168.1.7/32", "2001:db8::/32"},
+				}},
+			}},
+		},
+		{
+			name: "invalid blocks",
+			rules: []v1alpha1.UDPRouteRule{{
+				Matches: []v1alpha1.UDPRouteMatch{{
+					SourceCIDRs: []string{"10.0.0.0", "10.1.0.0/8"},
+				}, {
+					SourceCIDRs: []string{"2001:db8::/32", "2001:0db8::/32"},
+				}},
+			}},
+			want: []string{
+				"spec.rules[0].matches[0].sourceCIDRs[0]",
+				"spec.rules[0].matches[0].sourceCIDRs[1]",
+				"spec.rules[0].matches[1].sourceCIDRs[1]",
+			},
+		},
+		{
+			name: "same block in different rules",
+			rules: []v1alpha1.UDPRouteRule{{
+				Matches: []v1alpha1.UDPRouteMatch{{SourceCIDRs: []string{"10.0.0.0/8"}}},
+			}, {
+				Matches: []v1alpha1.UDPRouteMatch{{SourceCIDRs: []string{"10.0.0.0/8"}}},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			route := &v1alpha1.UDPRoute{Spec: v1alpha1.UDPRouteSpec{Rules: tc.rules}}
+			errs := ValidateUDPRoute(route)
+
+			if len(errs) != len(tc.want) {
+				t.Fatalf("got errors %v, want errors for %v", errs, tc.want)
+			}
+			for i, err := range errs {
+				if err.Field != tc.want[i] {
+					t.Errorf("error %d: got field %s, want %s", i, err.Field, tc.want[i])
+				}
+			}
+		})
+	}
+}
@@ -1,3 +1,4 @@
+//go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
 /*
@@ -25,6 +26,113 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendConnectionPool) DeepCopyInto(out *BackendConnectionPool) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRequestsPerConnection != nil {
+		in, out := &in.MaxRequestsPerConnection, &out.MaxRequestsPerConnection
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendConnectionPool.
+func (in *BackendConnectionPool) DeepCopy() *BackendConnectionPool {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendConnectionPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendHealthCheck) DeepCopyInto(out *BackendHealthCheck) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	out.Interval = in.Interval
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendHealthCheck.
+func (in *BackendHealthCheck) DeepCopy() *BackendHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendLoadBalancer) DeepCopyInto(out *BackendLoadBalancer) {
+	*out = *in
+	if in.HashOn != nil {
+		in, out := &in.HashOn, &out.HashOn
+		*out = new(LoadBalancerHashKey)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendLoadBalancer.
+func (in *BackendLoadBalancer) DeepCopy() *BackendLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendOutlierDetection) DeepCopyInto(out *BackendOutlierDetection) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	out.BaseEjectionTime = in.BaseEjectionTime
+	if in.MaxEjectionPercent != nil {
+		in, out := &in.MaxEjectionPercent, &out.MaxEjectionPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendOutlierDetection.
+func (in *BackendOutlierDetection) DeepCopy() *BackendOutlierDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendOutlierDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendPolicy) DeepCopyInto(out *BackendPolicy) {
 	*out = *in
@@ -99,6 +207,31 @@ func (in *BackendPolicySpec) DeepCopyInto(out *BackendPolicySpec) {
 		*out = new(BackendTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(BackendLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(BackendHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SessionAffinity != nil {
+		in, out := &in.SessionAffinity, &out.SessionAffinity
+		*out = new(BackendSessionAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionPool != nil {
+		in, out := &in.ConnectionPool, &out.ConnectionPool
+		*out = new(BackendConnectionPool)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutlierDetection != nil {
+		in, out := &in.OutlierDetection, &out.OutlierDetection
+		*out = new(BackendOutlierDetection)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendPolicySpec.
@@ -121,6 +254,11 @@ func (in *BackendPolicyStatus) DeepCopyInto(out *BackendPolicyStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UnsupportedSettings != nil {
+		in, out := &in.UnsupportedSettings, &out.UnsupportedSettings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendPolicyStatus.
@@ -153,6 +291,26 @@ func (in *BackendRef) DeepCopy() *BackendRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendSessionAffinity) DeepCopyInto(out *BackendSessionAffinity) {
+	*out = *in
+	if in.Cookie != nil {
+		in, out := &in.Cookie, &out.Cookie
+		*out = new(SessionAffinityCookieConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendSessionAffinity.
+func (in *BackendSessionAffinity) DeepCopy() *BackendSessionAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendSessionAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendTLSConfig) DeepCopyInto(out *BackendTLSConfig) {
 	*out = *in
@@ -166,6 +324,7 @@ func (in *BackendTLSConfig) DeepCopyInto(out *BackendTLSConfig) {
 		*out = new(LocalObjectReference)
 		**out = **in
 	}
+	in.TLSParameters.DeepCopyInto(&out.TLSParameters)
 	if in.Options != nil {
 		in, out := &in.Options, &out.Options
 		*out = make(map[string]string, len(*in))
@@ -185,6 +344,209 @@ func (in *BackendTLSConfig) DeepCopy() *BackendTLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateObjectReference) DeepCopyInto(out *CertificateObjectReference) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateObjectReference.
+func (in *CertificateObjectReference) DeepCopy() *CertificateObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCMethodMatch) DeepCopyInto(out *GRPCMethodMatch) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(string)
+		**out = **in
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCMethodMatch.
+func (in *GRPCMethodMatch) DeepCopy() *GRPCMethodMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCMethodMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRoute) DeepCopyInto(out *GRPCRoute) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRoute.
+func (in *GRPCRoute) DeepCopy() *GRPCRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCRoute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRouteList) DeepCopyInto(out *GRPCRouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GRPCRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteList.
+func (in *GRPCRouteList) DeepCopy() *GRPCRouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRouteMatch) DeepCopyInto(out *GRPCRouteMatch) {
+	*out = *in
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(GRPCMethodMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeaderMatcher, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteMatch.
+func (in *GRPCRouteMatch) DeepCopy() *GRPCRouteMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRouteMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRouteRule) DeepCopyInto(out *GRPCRouteRule) {
+	*out = *in
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]GRPCRouteMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ForwardTo != nil {
+		in, out := &in.ForwardTo, &out.ForwardTo
+		*out = make([]RouteForwardTo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteRule.
+func (in *GRPCRouteRule) DeepCopy() *GRPCRouteRule {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRouteRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRouteSpec) DeepCopyInto(out *GRPCRouteSpec) {
+	*out = *in
+	in.Gateways.DeepCopyInto(&out.Gateways)
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]HTTPRouteHostname, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]GRPCRouteRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteSpec.
+func (in *GRPCRouteSpec) DeepCopy() *GRPCRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRouteStatus) DeepCopyInto(out *GRPCRouteStatus) {
+	*out = *in
+	in.RouteStatus.DeepCopyInto(&out.RouteStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteStatus.
+func (in *GRPCRouteStatus) DeepCopy() *GRPCRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Gateway) DeepCopyInto(out *Gateway) {
 	*out = *in
@@ -445,8 +807,14 @@ func (in *GatewayStatus) DeepCopy() *GatewayStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayTLSConfig) DeepCopyInto(out *GatewayTLSConfig) {
 	*out = *in
-	out.CertificateRef = in.CertificateRef
+	in.CertificateRef.DeepCopyInto(&out.CertificateRef)
 	out.RouteOverride = in.RouteOverride
+	in.TLSParameters.DeepCopyInto(&out.TLSParameters)
+	if in.ClientValidation != nil {
+		in, out := &in.ClientValidation, &out.ClientValidation
+		*out = new(TLSClientValidation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Options != nil {
 		in, out := &in.Options, &out.Options
 		*out = make(map[string]string, len(*in))
@@ -476,6 +844,11 @@ func (in *HTTPHeaderMatch) DeepCopyInto(out *HTTPHeaderMatch) {
 			(*out)[key] = val
 		}
 	}
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]HTTPHeaderMatcher, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderMatch.
@@ -488,6 +861,56 @@ func (in *HTTPHeaderMatch) DeepCopy() *HTTPHeaderMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderMatcher) DeepCopyInto(out *HTTPHeaderMatcher) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderMatcher.
+func (in *HTTPHeaderMatcher) DeepCopy() *HTTPHeaderMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHealthCheck) DeepCopyInto(out *HTTPHealthCheck) {
+	*out = *in
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHealthCheck.
+func (in *HTTPHealthCheck) DeepCopy() *HTTPHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPMethodMatch) DeepCopyInto(out *HTTPMethodMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPMethodMatch.
+func (in *HTTPMethodMatch) DeepCopy() *HTTPMethodMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPMethodMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPPathMatch) DeepCopyInto(out *HTTPPathMatch) {
 	*out = *in
@@ -503,9 +926,167 @@ func (in *HTTPPathMatch) DeepCopy() *HTTPPathMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPathModifier) DeepCopyInto(out *HTTPPathModifier) {
+	*out = *in
+	if in.ReplaceFullPath != nil {
+		in, out := &in.ReplaceFullPath, &out.ReplaceFullPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplacePrefixMatch != nil {
+		in, out := &in.ReplacePrefixMatch, &out.ReplacePrefixMatch
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPathModifier.
+func (in *HTTPPathModifier) DeepCopy() *HTTPPathModifier {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPathModifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPQueryParamMatch) DeepCopyInto(out *HTTPQueryParamMatch) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPQueryParamMatch.
+func (in *HTTPQueryParamMatch) DeepCopy() *HTTPQueryParamMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPQueryParamMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPRequestHeaderFilter) DeepCopyInto(out *HTTPRequestHeaderFilter) {
 	*out = *in
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Add != nil {
+		in, out := &in.Add, &out.Add
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Remove != nil {
+		in, out := &in.Remove, &out.Remove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestHeaderFilter.
+func (in *HTTPRequestHeaderFilter) DeepCopy() *HTTPRequestHeaderFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequestHeaderFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRequestMirrorFilter) DeepCopyInto(out *HTTPRequestMirrorFilter) {
+	*out = *in
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestMirrorFilter.
+func (in *HTTPRequestMirrorFilter) DeepCopy() *HTTPRequestMirrorFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequestMirrorFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRequestRedirectFilter) DeepCopyInto(out *HTTPRequestRedirectFilter) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestRedirectFilter.
+func (in *HTTPRequestRedirectFilter) DeepCopy() *HTTPRequestRedirectFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequestRedirectFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPResponseHeaderFilter) DeepCopyInto(out *HTTPResponseHeaderFilter) {
+	*out = *in
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Add != nil {
 		in, out := &in.Add, &out.Add
 		*out = make(map[string]string, len(*in))
@@ -520,42 +1101,79 @@ func (in *HTTPRequestHeaderFilter) DeepCopyInto(out *HTTPRequestHeaderFilter) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestHeaderFilter.
-func (in *HTTPRequestHeaderFilter) DeepCopy() *HTTPRequestHeaderFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPResponseHeaderFilter.
+func (in *HTTPResponseHeaderFilter) DeepCopy() *HTTPResponseHeaderFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPResponseHeaderFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRetryBackoff) DeepCopyInto(out *HTTPRetryBackoff) {
+	*out = *in
+	out.BaseInterval = in.BaseInterval
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRetryBackoff.
+func (in *HTTPRetryBackoff) DeepCopy() *HTTPRetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRetryOn) DeepCopyInto(out *HTTPRetryOn) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]HTTPRetryStatusCode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRetryOn.
+func (in *HTTPRetryOn) DeepCopy() *HTTPRetryOn {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPRequestHeaderFilter)
+	out := new(HTTPRetryOn)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPRequestMirrorFilter) DeepCopyInto(out *HTTPRequestMirrorFilter) {
+func (in *HTTPRetryPolicy) DeepCopyInto(out *HTTPRetryPolicy) {
 	*out = *in
-	if in.ServiceName != nil {
-		in, out := &in.ServiceName, &out.ServiceName
-		*out = new(string)
-		**out = **in
-	}
-	if in.BackendRef != nil {
-		in, out := &in.BackendRef, &out.BackendRef
-		*out = new(LocalObjectReference)
+	if in.PerTryTimeout != nil {
+		in, out := &in.PerTryTimeout, &out.PerTryTimeout
+		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int32)
-		**out = **in
+	in.RetryOn.DeepCopyInto(&out.RetryOn)
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(HTTPRetryBackoff)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestMirrorFilter.
-func (in *HTTPRequestMirrorFilter) DeepCopy() *HTTPRequestMirrorFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRetryPolicy.
+func (in *HTTPRetryPolicy) DeepCopy() *HTTPRetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPRequestMirrorFilter)
+	out := new(HTTPRetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -600,11 +1218,26 @@ func (in *HTTPRouteFilter) DeepCopyInto(out *HTTPRouteFilter) {
 		*out = new(HTTPRequestHeaderFilter)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResponseHeader != nil {
+		in, out := &in.ResponseHeader, &out.ResponseHeader
+		*out = new(HTTPResponseHeaderFilter)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RequestMirror != nil {
 		in, out := &in.RequestMirror, &out.RequestMirror
 		*out = new(HTTPRequestMirrorFilter)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RequestRedirect != nil {
+		in, out := &in.RequestRedirect, &out.RequestRedirect
+		*out = new(HTTPRequestRedirectFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.URLRewrite != nil {
+		in, out := &in.URLRewrite, &out.URLRewrite
+		*out = new(HTTPURLRewriteFilter)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteFilter.
@@ -630,6 +1263,11 @@ func (in *HTTPRouteForwardTo) DeepCopyInto(out *HTTPRouteForwardTo) {
 		*out = new(LocalObjectReference)
 		**out = **in
 	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
 	if in.Port != nil {
 		in, out := &in.Port, &out.Port
 		*out = new(int32)
@@ -695,6 +1333,16 @@ func (in *HTTPRouteMatch) DeepCopyInto(out *HTTPRouteMatch) {
 		*out = new(HTTPHeaderMatch)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(HTTPMethodMatch)
+		**out = **in
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = new(HTTPQueryParamMatch)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExtensionRef != nil {
 		in, out := &in.ExtensionRef, &out.ExtensionRef
 		*out = new(LocalObjectReference)
@@ -736,6 +1384,16 @@ func (in *HTTPRouteRule) DeepCopyInto(out *HTTPRouteRule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(HTTPRouteTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(HTTPRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteRule.
@@ -760,7 +1418,7 @@ func (in *HTTPRouteSpec) DeepCopyInto(out *HTTPRouteSpec) {
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(RouteTLSConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Rules != nil {
 		in, out := &in.Rules, &out.Rules
@@ -797,6 +1455,56 @@ func (in *HTTPRouteStatus) DeepCopy() *HTTPRouteStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteTimeouts) DeepCopyInto(out *HTTPRouteTimeouts) {
+	*out = *in
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BackendRequest != nil {
+		in, out := &in.BackendRequest, &out.BackendRequest
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteTimeouts.
+func (in *HTTPRouteTimeouts) DeepCopy() *HTTPRouteTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPURLRewriteFilter) DeepCopyInto(out *HTTPURLRewriteFilter) {
+	*out = *in
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(HTTPPathModifier)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPURLRewriteFilter.
+func (in *HTTPURLRewriteFilter) DeepCopy() *HTTPURLRewriteFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPURLRewriteFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostnameMatch) DeepCopyInto(out *HostnameMatch) {
 	*out = *in
@@ -856,6 +1564,21 @@ func (in *ListenerStatus) DeepCopy() *ListenerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerHashKey) DeepCopyInto(out *LoadBalancerHashKey) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerHashKey.
+func (in *LoadBalancerHashKey) DeepCopy() *LoadBalancerHashKey {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerHashKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalObjectReference) DeepCopyInto(out *LocalObjectReference) {
 	*out = *in
@@ -871,6 +1594,126 @@ func (in *LocalObjectReference) DeepCopy() *LocalObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrant) DeepCopyInto(out *ReferenceGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrant.
+func (in *ReferenceGrant) DeepCopy() *ReferenceGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReferenceGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrantFrom) DeepCopyInto(out *ReferenceGrantFrom) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantFrom.
+func (in *ReferenceGrantFrom) DeepCopy() *ReferenceGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrantList) DeepCopyInto(out *ReferenceGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReferenceGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantList.
+func (in *ReferenceGrantList) DeepCopy() *ReferenceGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReferenceGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrantSpec) DeepCopyInto(out *ReferenceGrantSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]ReferenceGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]ReferenceGrantTo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantSpec.
+func (in *ReferenceGrantSpec) DeepCopy() *ReferenceGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrantTo) DeepCopyInto(out *ReferenceGrantTo) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantTo.
+func (in *ReferenceGrantTo) DeepCopy() *ReferenceGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteBindingSelector) DeepCopyInto(out *RouteBindingSelector) {
 	*out = *in
@@ -901,6 +1744,11 @@ func (in *RouteForwardTo) DeepCopyInto(out *RouteForwardTo) {
 		*out = new(LocalObjectReference)
 		**out = **in
 	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
 	if in.Port != nil {
 		in, out := &in.Port, &out.Port
 		*out = new(int32)
@@ -1002,7 +1850,7 @@ func (in *RouteStatus) DeepCopy() *RouteStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTLSConfig) DeepCopyInto(out *RouteTLSConfig) {
 	*out = *in
-	out.CertificateRef = in.CertificateRef
+	in.CertificateRef.DeepCopyInto(&out.CertificateRef)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTLSConfig.
@@ -1015,6 +1863,26 @@ func (in *RouteTLSConfig) DeepCopy() *RouteTLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionAffinityCookieConfig) DeepCopyInto(out *SessionAffinityCookieConfig) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionAffinityCookieConfig.
+func (in *SessionAffinityCookieConfig) DeepCopy() *SessionAffinityCookieConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SessionAffinityCookieConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCPRoute) DeepCopyInto(out *TCPRoute) {
 	*out = *in
@@ -1077,6 +1945,16 @@ func (in *TCPRouteList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCPRouteMatch) DeepCopyInto(out *TCPRouteMatch) {
 	*out = *in
+	if in.SourceCIDRs != nil {
+		in, out := &in.SourceCIDRs, &out.SourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ExtensionRef != nil {
 		in, out := &in.ExtensionRef, &out.ExtensionRef
 		*out = new(LocalObjectReference)
@@ -1162,6 +2040,37 @@ func (in *TCPRouteStatus) DeepCopy() *TCPRouteStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSClientValidation) DeepCopyInto(out *TLSClientValidation) {
+	*out = *in
+	out.CACertificateRef = in.CACertificateRef
+	if in.SubjectAltNames != nil {
+		in, out := &in.SubjectAltNames, &out.SubjectAltNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IdentityHeader != nil {
+		in, out := &in.IdentityHeader, &out.IdentityHeader
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSClientValidation.
+func (in *TLSClientValidation) DeepCopy() *TLSClientValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSClientValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSOverridePolicy) DeepCopyInto(out *TLSOverridePolicy) {
 	*out = *in
@@ -1177,6 +2086,41 @@ func (in *TLSOverridePolicy) DeepCopy() *TLSOverridePolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSParameters) DeepCopyInto(out *TLSParameters) {
+	*out = *in
+	if in.MinVersion != nil {
+		in, out := &in.MinVersion, &out.MinVersion
+		*out = new(TLSVersion)
+		**out = **in
+	}
+	if in.MaxVersion != nil {
+		in, out := &in.MaxVersion, &out.MaxVersion
+		*out = new(TLSVersion)
+		**out = **in
+	}
+	if in.CipherSuites != nil {
+		in, out := &in.CipherSuites, &out.CipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ALPNProtocols != nil {
+		in, out := &in.ALPNProtocols, &out.ALPNProtocols
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSParameters.
+func (in *TLSParameters) DeepCopy() *TLSParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSRoute) DeepCopyInto(out *TLSRoute) {
 	*out = *in
@@ -1244,6 +2188,16 @@ func (in *TLSRouteMatch) DeepCopyInto(out *TLSRouteMatch) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ALPNProtocols != nil {
+		in, out := &in.ALPNProtocols, &out.ALPNProtocols
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ExtensionRef != nil {
 		in, out := &in.ExtensionRef, &out.ExtensionRef
 		*out = new(LocalObjectReference)
@@ -1391,6 +2345,16 @@ func (in *UDPRouteList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UDPRouteMatch) DeepCopyInto(out *UDPRouteMatch) {
 	*out = *in
+	if in.SourceCIDRs != nil {
+		in, out := &in.SourceCIDRs, &out.SourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ExtensionRef != nil {
 		in, out := &in.ExtensionRef, &out.ExtensionRef
 		*out = new(LocalObjectReference)
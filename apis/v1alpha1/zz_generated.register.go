@@ -60,12 +60,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&BackendPolicy{},
 		&BackendPolicyList{},
+		&GRPCRoute{},
+		&GRPCRouteList{},
 		&Gateway{},
 		&GatewayClass{},
 		&GatewayClassList{},
 		&GatewayList{},
 		&HTTPRoute{},
 		&HTTPRouteList{},
+		&ReferenceGrant{},
+		&ReferenceGrantList{},
 		&TCPRoute{},
 		&TCPRouteList{},
 		&TLSRoute{},
@@ -0,0 +1,65 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha2"
+	"sigs.k8s.io/service-apis/pkg/apitesting"
+	applyv1alpha2 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha2"
+)
+
+// gatewayStatus is the route status that the controller of one Gateway
+// applies.
+func gatewayStatus(gateway string, admitted metav1.ConditionStatus) *applyv1alpha2.HTTPRouteApplyConfiguration {
+	return applyv1alpha2.HTTPRoute("web", "default").
+		WithStatus(applyv1alpha2.HTTPRouteStatus().
+			WithGateways(applyv1alpha2.RouteGatewayStatus().
+				WithName(gateway).
+				WithNamespace("infra").
+				WithConditions(metav1.Condition{
+					Type:               "Admitted",
+					Status:             admitted,
+					Reason:             "Reconciled",
+					LastTransitionTime: metav1.Unix(0, 0),
+				})))
+}
+
+func TestApplyRouteStatusPerGateway(t *testing.T) {
+	var route v1alpha2.HTTPRoute
+	apitesting.ServerSideApply(t, "../../config/crd/bases", &route,
+		apitesting.ApplyRequest{Manager: "controller-a", Object: gatewayStatus("gateway-a", metav1.ConditionTrue)},
+		apitesting.ApplyRequest{Manager: "controller-b", Object: gatewayStatus("gateway-b", metav1.ConditionTrue)},
+		apitesting.ApplyRequest{Manager: "controller-a", Object: gatewayStatus("gateway-a", metav1.ConditionFalse)},
+	)
+
+	want := map[string]metav1.ConditionStatus{
+		"gateway-a": metav1.ConditionFalse,
+		"gateway-b": metav1.ConditionTrue,
+	}
+	if len(route.Status.Gateways) != len(want) {
+		t.Fatalf("got %d gateway entries, want %d: %+v", len(route.Status.Gateways), len(want), route.Status.Gateways)
+	}
+	for _, gw := range route.Status.Gateways {
+		if len(gw.Conditions) != 1 || gw.Conditions[0].Status != want[gw.Name] {
+			t.Errorf("entry for %s/%s has conditions %+v, want Admitted %s", gw.Namespace, gw.Name, gw.Conditions, want[gw.Name])
+		}
+	}
+}
@@ -0,0 +1,508 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// BackendPolicy defines policies associated with backends. For the purpose of
+// this API, a backend is defined as any resource that a route can forward
+// traffic to. A common example of a backend is a Service. Configuration that is
+// implementation specific may be represented with similar implementation
+// specific custom resources.
+type BackendPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackendPolicySpec   `json:"spec,omitempty"`
+	Status BackendPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackendPolicyList contains a list of BackendPolicy
+type BackendPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackendPolicy `json:"items"`
+}
+
+// BackendPolicySpec defines desired policy for a backend.
+type BackendPolicySpec struct {
+	// BackendRefs define which backends this policy should be applied to. This
+	// policy can only apply to backends within the same namespace. If more than
+	// one BackendPolicy targets the same backend, precedence must be given to
+	// the oldest BackendPolicy.
+	//
+	// Support: Core
+	// +kubebuilder:validation:MaxItems=16
+	BackendRefs []BackendRef `json:"backendRefs"`
+
+	// TLS is the TLS configuration for these backends.
+	//
+	// Support: Extended
+	// +optional
+	TLS *BackendTLSConfig `json:"tls,omitempty"`
+
+	// LoadBalancer defines how requests are distributed across the
+	// endpoints of these backends. If unspecified, the algorithm is
+	// implementation-specific.
+	//
+	// Support: Extended
+	// +optional
+	LoadBalancer *BackendLoadBalancer `json:"loadBalancer,omitempty"`
+
+	// HealthCheck defines an active health check of the endpoints of these
+	// backends. Endpoints that fail the health check do not receive
+	// traffic. If unspecified, endpoints are not actively health checked.
+	//
+	// Support: Extended
+	// +optional
+	HealthCheck *BackendHealthCheck `json:"healthCheck,omitempty"`
+
+	// SessionAffinity defines how requests from the same client are sent to
+	// the same endpoint. If unspecified, there is no session affinity.
+	//
+	// Support: Extended
+	// +optional
+	SessionAffinity *BackendSessionAffinity `json:"sessionAffinity,omitempty"`
+
+	// ConnectionPool limits the connections and requests from each Gateway
+	// instance to these backends. Requests that exceed the limits fail
+	// immediately instead of queueing. If unspecified, the limits are
+	// implementation-specific.
+	//
+	// Support: Extended
+	// +optional
+	ConnectionPool *BackendConnectionPool `json:"connectionPool,omitempty"`
+
+	// OutlierDetection defines how endpoints that keep failing are
+	// temporarily removed from load balancing. If unspecified, endpoints
+	// are not ejected.
+	//
+	// Support: Extended
+	// +optional
+	OutlierDetection *BackendOutlierDetection `json:"outlierDetection,omitempty"`
+}
+
+// BackendConnectionPool describes connection pool limits for a backend.
+// Limits apply to all endpoints of the backend together.
+type BackendConnectionPool struct {
+	// MaxConnections is the maximum number of connections to the backend.
+	//
+	// Support: Extended
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+
+	// MaxPendingRequests is the maximum number of requests waiting for a
+	// connection to the backend.
+	//
+	// Support: Extended
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxPendingRequests *int32 `json:"maxPendingRequests,omitempty"`
+
+	// MaxRequestsPerConnection is the maximum number of requests sent over
+	// a single connection before it is closed. If unspecified, connections
+	// are reused without limit.
+	//
+	// Support: Extended
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRequestsPerConnection *int32 `json:"maxRequestsPerConnection,omitempty"`
+
+	// IdleTimeout is the time after which a connection without requests is
+	// closed. Durations are specified in the format accepted by Go's
+	// time.ParseDuration.
+	//
+	// Support: Extended
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+}
+
+// BackendOutlierDetection describes how failing endpoints are ejected from
+// load balancing. An ejected endpoint returns to load balancing after the
+// ejection time, which grows with each consecutive ejection.
+//
+// For example, the following ejects an endpoint for 30s after 5
+// consecutive 5xx responses, while keeping at least half of the endpoints
+// in load balancing:
+//
+// ```
+// outlierDetection:
+//   consecutive5xxErrors: 5
+//   baseEjectionTime: 30s
+//   maxEjectionPercent: 50
+// ```
+type BackendOutlierDetection struct {
+	// Consecutive5xxErrors is the number of consecutive 5xx responses, or
+	// connection failures, after which an endpoint is ejected.
+	//
+	// Support: Extended
+	// +optional
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	Consecutive5xxErrors int32 `json:"consecutive5xxErrors,omitempty"`
+
+	// Interval is the time between scans of the endpoints for outliers. If
+	// unspecified, it is implementation-specific.
+	//
+	// Support: Extended
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// BaseEjectionTime is the time an endpoint is ejected for the first
+	// time. Each further ejection multiplies it by the number of times the
+	// endpoint has been ejected.
+	//
+	// Support: Extended
+	BaseEjectionTime metav1.Duration `json:"baseEjectionTime"`
+
+	// MaxEjectionPercent is the maximum percentage of the endpoints of the
+	// backend that can be ejected at the same time. At least one endpoint
+	// can always be ejected.
+	//
+	// Support: Extended
+	// +optional
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxEjectionPercent *int32 `json:"maxEjectionPercent,omitempty"`
+}
+
+// LoadBalancerType is the algorithm used to choose an endpoint for each
+// request.
+//
+// +kubebuilder:validation:Enum=RoundRobin;LeastRequest;RingHash
+type LoadBalancerType string
+
+const (
+	// LoadBalancerRoundRobin chooses endpoints in turn.
+	LoadBalancerRoundRobin LoadBalancerType = "RoundRobin"
+
+	// LoadBalancerLeastRequest chooses the endpoint with the fewest
+	// outstanding requests.
+	LoadBalancerLeastRequest LoadBalancerType = "LeastRequest"
+
+	// LoadBalancerRingHash chooses endpoints by consistent hashing of a
+	// request attribute, so that requests with the same attribute value
+	// are sent to the same endpoint while it is available.
+	LoadBalancerRingHash LoadBalancerType = "RingHash"
+)
+
+// BackendLoadBalancer describes the load balancing algorithm for a backend.
+//
+// For example, the following sends requests with the same "x-user-id"
+// header to the same endpoint:
+//
+// ```
+// loadBalancer:
+//   type: RingHash
+//   hashOn:
+//     type: Header
+//     name: x-user-id
+// ```
+type BackendLoadBalancer struct {
+	// Type is the load balancing algorithm.
+	//
+	// Support: Extended
+	// +optional
+	// +kubebuilder:default=RoundRobin
+	Type LoadBalancerType `json:"type,omitempty"`
+
+	// HashOn is the request attribute that is hashed. It must be set if
+	// and only if Type is RingHash.
+	//
+	// Support: Extended
+	// +optional
+	HashOn *LoadBalancerHashKey `json:"hashOn,omitempty"`
+}
+
+// HashKeyType is the kind of request attribute used as a hash key.
+//
+// +kubebuilder:validation:Enum=Header;Cookie
+type HashKeyType string
+
+const (
+	// HashKeyHeader hashes the value of a request header.
+	HashKeyHeader HashKeyType = "Header"
+
+	// HashKeyCookie hashes the value of a request cookie.
+	HashKeyCookie HashKeyType = "Cookie"
+)
+
+// LoadBalancerHashKey identifies the request attribute used as a hash key.
+// Requests without the attribute are distributed as with RoundRobin.
+type LoadBalancerHashKey struct {
+	// Type is the kind of request attribute.
+	//
+	// Support: Extended
+	Type HashKeyType `json:"type"`
+
+	// Name is the name of the header or cookie.
+	//
+	// Support: Extended
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+}
+
+// HealthCheckType is the protocol used to health check an endpoint.
+//
+// +kubebuilder:validation:Enum=HTTP;TCP
+type HealthCheckType string
+
+const (
+	// HealthCheckHTTP sends an HTTP GET request to the endpoint. The check
+	// succeeds if the response has a 2xx status code.
+	HealthCheckHTTP HealthCheckType = "HTTP"
+
+	// HealthCheckTCP opens a TCP connection to the endpoint. The check
+	// succeeds if the connection is established.
+	HealthCheckTCP HealthCheckType = "TCP"
+)
+
+// BackendHealthCheck describes an active health check.
+type BackendHealthCheck struct {
+	// Type is the protocol of the health check.
+	//
+	// Support: Extended
+	Type HealthCheckType `json:"type"`
+
+	// HTTP configures the request of an HTTP health check. It must be set
+	// if and only if Type is HTTP.
+	//
+	// Support: Extended
+	// +optional
+	HTTP *HTTPHealthCheck `json:"http,omitempty"`
+
+	// Interval is the time between health checks of an endpoint. Durations
+	// are specified in the format accepted by Go's time.ParseDuration.
+	//
+	// Support: Extended
+	Interval metav1.Duration `json:"interval"`
+
+	// Timeout is the time to wait for a health check to succeed. It must be
+	// shorter than Interval. If unspecified, it is implementation-specific.
+	//
+	// Support: Extended
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// HealthyThreshold is the number of consecutive successful checks
+	// needed to mark an unhealthy endpoint healthy.
+	//
+	// Support: Extended
+	// +optional
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	HealthyThreshold int32 `json:"healthyThreshold,omitempty"`
+
+	// UnhealthyThreshold is the number of consecutive failed checks needed
+	// to mark a healthy endpoint unhealthy.
+	//
+	// Support: Extended
+	// +optional
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	UnhealthyThreshold int32 `json:"unhealthyThreshold,omitempty"`
+}
+
+// HTTPHealthCheck describes the request sent by an HTTP health check.
+type HTTPHealthCheck struct {
+	// Path is the HTTP path of the request.
+	//
+	// Support: Extended
+	// +kubebuilder:validation:Pattern=^/
+	// +kubebuilder:validation:MaxLength=1024
+	Path string `json:"path"`
+
+	// Hostname is the Host header of the request. If unspecified, the
+	// implementation chooses the Host header.
+	//
+	// Support: Extended
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Hostname *string `json:"hostname,omitempty"`
+}
+
+// SessionAffinityType is the mechanism used to keep a client on the same
+// endpoint.
+//
+// +kubebuilder:validation:Enum=Cookie
+type SessionAffinityType string
+
+const (
+	// SessionAffinityCookie keeps a client on the same endpoint by setting
+	// a cookie on the first response that identifies the endpoint.
+	SessionAffinityCookie SessionAffinityType = "Cookie"
+)
+
+// BackendSessionAffinity describes session affinity for a backend. Session
+// affinity takes precedence over the load balancing algorithm for requests
+// that carry an affinity cookie of an available endpoint.
+type BackendSessionAffinity struct {
+	// Type is the session affinity mechanism.
+	//
+	// Support: Extended
+	// +optional
+	// +kubebuilder:default=Cookie
+	Type SessionAffinityType `json:"type,omitempty"`
+
+	// Cookie configures the affinity cookie. It must be set if Type is
+	// Cookie.
+	//
+	// Support: Extended
+	// +optional
+	Cookie *SessionAffinityCookieConfig `json:"cookie,omitempty"`
+}
+
+// SessionAffinityCookieConfig describes the cookie used for session
+// affinity.
+type SessionAffinityCookieConfig struct {
+	// Name is the name of the cookie.
+	//
+	// Support: Extended
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// TTL is the lifetime of the cookie. If unspecified, the cookie is a
+	// session cookie.
+	//
+	// Support: Extended
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// BackendRef identifies an API object within a known namespace that defaults
+// group to core and resource to services if unspecified.
+type BackendRef struct {
+	// Group is the group of the referent.
+	// +kubebuilder:validation:MaxLength=253
+	Group string `json:"group"`
+
+	// Kind is the kind of the referent.
+	// +kubebuilder:validation:MaxLength=253
+	Kind string `json:"kind,omitempty"`
+
+	// Name is the name of the referent.
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Port is the port of the referent. If unspecified, this policy applies to
+	// all ports on the backend.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+}
+
+// BackendTLSConfig describes TLS configuration for a backend.
+type BackendTLSConfig struct {
+	// ClientCertificateRef is a reference to a TLS client certificate-key pair
+	// that may be used to connect to these backends. If an entry in this list
+	// omits or specifies the empty string for both the group and the resource,
+	// the resource defaults to "secrets". An implementation may support other
+	// resources (for example, resource "mycertificates" in group
+	// "networking.acme.io").
+	//
+	// If a Secret is referenced, it must be of type "kubernetes.io/tls" and
+	// contain tls.crt and tls.key data fields that contain the certificate and
+	// private key to use for TLS.
+	//
+	// Support: Extended
+	//
+	// +optional
+	ClientCertificateRef *LocalObjectReference `json:"clientCertificateRef,omitempty"`
+
+	// CertificateAuthorityRef is a reference to a resource that includes
+	// trusted CA certificates for the associated backends. If an entry in this
+	// list omits or specifies the empty string for both the group and the
+	// resource, the resource defaults to "secrets". An implementation may
+	// support other resources (for example, resource "mycertificates" in group
+	// "networking.acme.io").
+	//
+	// When stored in a Secret, certificates must be PEM encoded and specified
+	// within the "ca.crt" data field of the Secret. Multiple certificates can
+	// be specified, concatenated by new lines.
+	//
+	// Support: Extended
+	//
+	// +optional
+	CertificateAuthorityRef *LocalObjectReference `json:"certificateAuthorityRef,omitempty"`
+
+	// TLSParameters define the protocol versions, cipher suites and ALPN
+	// protocols negotiated with these backends.
+	//
+	// Support: Extended
+	TLSParameters `json:",inline"`
+
+	// Options are a list of key/value pairs to give extended options to the
+	// provider. Settings that have a typed field, such as the minimum TLS
+	// version, must not be expressed as options.
+	//
+	// Support: Implementation-specific.
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// BackendPolicyStatus defines the observed state of BackendPolicy. Conditions
+// that are related to a specific Route or Gateway should be placed on the
+// Route(s) using backends configured by this BackendPolicy.
+type BackendPolicyStatus struct {
+	// Conditions describe the current conditions of the BackendPolicy.
+	//
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// UnsupportedSettings lists the paths of the spec fields that are set
+	// but not supported by the controller, for example
+	// "spec.loadBalancer.hashOn". The controller ignores these settings.
+	// When this list is not empty, the UnsupportedSettings condition must
+	// be true.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	UnsupportedSettings []string `json:"unsupportedSettings,omitempty"`
+}
+
+// BackendPolicyConditionType is a type of condition associated with a
+// BackendPolicy.
+type BackendPolicyConditionType string
+
+const (
+	// ConditionNoSuchBackend indicates that one or more of the the specified
+	// Backends does not exist.
+	ConditionNoSuchBackend BackendPolicyConditionType = "NoSuchBackend"
+
+	// ConditionUnsupportedSettings indicates that one or more of the
+	// settings of the BackendPolicy are not supported by the controller
+	// and are ignored. The settings are listed in UnsupportedSettings.
+	ConditionUnsupportedSettings BackendPolicyConditionType = "UnsupportedSettings"
+)
@@ -0,0 +1,44 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+// Hub marks BackendPolicy as a conversion hub.
+func (*BackendPolicy) Hub() {}
+
+// Hub marks GRPCRoute as a conversion hub.
+func (*GRPCRoute) Hub() {}
+
+// Hub marks Gateway as a conversion hub.
+func (*Gateway) Hub() {}
+
+// Hub marks GatewayClass as a conversion hub.
+func (*GatewayClass) Hub() {}
+
+// Hub marks HTTPRoute as a conversion hub.
+func (*HTTPRoute) Hub() {}
+
+// Hub marks ReferenceGrant as a conversion hub.
+func (*ReferenceGrant) Hub() {}
+
+// Hub marks TCPRoute as a conversion hub.
+func (*TCPRoute) Hub() {}
+
+// Hub marks TLSRoute as a conversion hub.
+func (*TLSRoute) Hub() {}
+
+// Hub marks UDPRoute as a conversion hub.
+func (*UDPRoute) Hub() {}
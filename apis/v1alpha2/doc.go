@@ -0,0 +1,28 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha2 contains API Schema definitions for the networking.x-k8s.io
+// API group.
+//
+// v1alpha2 is the hub version of the group: every other version converts
+// to and from it. Changes to the API are made here first, with conversion
+// functions in the older versions that preserve the fields those versions
+// cannot represent. The API server stores v1alpha2 only once the
+// conversion webhook is installed; the plain CRDs still store v1alpha1,
+// and do not serve the v1alpha2 route kinds, whose status differs.
+// +kubebuilder:object:generate=true
+// +groupName=networking.x-k8s.io
+package v1alpha2
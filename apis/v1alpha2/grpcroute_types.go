@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Hostnames",type=string,JSONPath=`.spec.hostnames`
+
+// GRPCRoute is the Schema for the GRPCRoute resource. It routes gRPC
+// requests by service, method and metadata headers, and can be bound to
+// HTTP and HTTPS listeners.
+type GRPCRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GRPCRouteSpec   `json:"spec,omitempty"`
+	Status GRPCRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GRPCRouteList contains a list of GRPCRoute
+type GRPCRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GRPCRoute `json:"items"`
+}
+
+// GRPCRouteSpec defines the desired state of GRPCRoute
+type GRPCRouteSpec struct {
+	// Gateways defines which Gateways can use this Route.
+	// +kubebuilder:default={allow: "SameNamespace"}
+	Gateways RouteGateways `json:"gateways,omitempty"`
+
+	// Hostnames defines a set of hostnames that should match against the
+	// HTTP/2 :authority pseudo-header to select a GRPCRoute to process the
+	// request. Hostnames follow the same rules as HTTPRoute hostnames.
+	//
+	// Support: Core
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Hostnames []HTTPRouteHostname `json:"hostnames,omitempty"`
+
+	// Rules are a list of gRPC matchers and actions.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Rules []GRPCRouteRule `json:"rules"`
+}
+
+// GRPCRouteStatus defines the observed state of GRPCRoute
+type GRPCRouteStatus struct {
+	RouteStatus `json:",inline"`
+}
+
+// GRPCRouteRule is the configuration for a given rule.
+type GRPCRouteRule struct {
+	// Matches define conditions used for matching the rule against
+	// incoming gRPC requests. Each match is independent, i.e. this rule
+	// will be matched if **any** one of the matches is satisfied.
+	//
+	// If no matches are specified, the rule matches every gRPC request.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	Matches []GRPCRouteMatch `json:"matches,omitempty"`
+
+	// ForwardTo defines the backend(s) where matching requests should be sent.
+	// +optional
+	// +kubebuilder:validation:MaxItems=4
+	ForwardTo []RouteForwardTo `json:"forwardTo,omitempty"`
+}
+
+// GRPCRouteMatch defines the predicate used to match requests to a given
+// action. Multiple match types are ANDed together, i.e. the match will
+// evaluate to true only if all conditions are satisfied.
+//
+// For example, the match below will match a gRPC request only if its
+// service is `foo.bar.Greeter` and it contains the `version: 2` metadata
+// header:
+//
+// ```
+// match:
+//   method:
+//     service: foo.bar.Greeter
+//   headers:
+//   - name: version
+//     value: "2"
+// ```
+type GRPCRouteMatch struct {
+	// Method specifies a gRPC service and method match. If unspecified,
+	// all services and methods are matched.
+	//
+	// Support: Core
+	//
+	// +optional
+	Method *GRPCMethodMatch `json:"method,omitempty"`
+
+	// Headers specifies metadata header matchers. All matchers must match.
+	// Metadata header names are matched case-insensitively.
+	//
+	// Support: Core (Exact, Present, Absent)
+	// Support: Extended (RegularExpression)
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Headers []HTTPHeaderMatcher `json:"headers,omitempty"`
+}
+
+// GRPCMethodMatchType specifies the semantics of how gRPC services and
+// methods should be compared.
+//
+// +kubebuilder:validation:Enum=Exact;RegularExpression
+type GRPCMethodMatchType string
+
+const (
+	// GRPCMethodMatchExact matches the service and method exactly.
+	GRPCMethodMatchExact GRPCMethodMatchType = "Exact"
+
+	// GRPCMethodMatchRegularExpression matches the service and method
+	// against regular expressions. The regular expression syntax is
+	// implementation-specific.
+	GRPCMethodMatchRegularExpression GRPCMethodMatchType = "RegularExpression"
+)
+
+// GRPCMethodMatch describes how to select a gRPC route by matching the
+// gRPC request service and/or method. At least one of Service and Method
+// must be specified.
+type GRPCMethodMatch struct {
+	// Type specifies how to match against the service and/or method.
+	//
+	// Support: Core (Exact with service and method specified)
+	// Support: Extended (Exact with only one of service or method specified)
+	// Support: Custom (RegularExpression)
+	//
+	// +optional
+	// +kubebuilder:default=Exact
+	Type GRPCMethodMatchType `json:"type,omitempty"`
+
+	// Service is the fully qualified name of the gRPC service, including
+	// its package, e.g. "foo.bar.Greeter". If unspecified, all services
+	// match.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Service *string `json:"service,omitempty"`
+
+	// Method is the name of the gRPC method, e.g. "SayHello". If
+	// unspecified, all methods match.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Method *string `json:"method,omitempty"`
+}
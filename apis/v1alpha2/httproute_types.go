@@ -0,0 +1,1252 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Hostnames",type=string,JSONPath=`.spec.hostnames`
+
+// HTTPRoute is the Schema for the HTTPRoute resource.
+type HTTPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HTTPRouteSpec   `json:"spec,omitempty"`
+	Status HTTPRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HTTPRouteList contains a list of HTTPRoute
+type HTTPRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HTTPRoute `json:"items"`
+}
+
+// HTTPRouteSpec defines the desired state of HTTPRoute
+type HTTPRouteSpec struct {
+	// Gateways defines which Gateways can use this Route.
+	// +kubebuilder:default={allow: "SameNamespace"}
+	Gateways RouteGateways `json:"gateways,omitempty"`
+
+	// Hostnames defines a set of hostname that should match against
+	// the HTTP Host header to select a HTTPRoute to process the request.
+	// Hostname is the fully qualified domain name of a network host,
+	// as defined by RFC 3986. Note the following deviations from the
+	// "host" part of the URI as defined in the RFC:
+	//
+	// 1. IPs are not allowed.
+	// 2. The `:` delimiter is not respected because ports are not allowed.
+	//
+	// Incoming requests are matched against the hostnames before the
+	// HTTPRoute rules. If no hostname is specified, traffic is routed
+	// based on the HTTPRouteRules.
+	//
+	// Hostname can be "precise" which is a domain name without the terminating
+	// dot of a network host (e.g. "foo.example.com") or "wildcard", which is
+	// a domain name prefixed with a single wildcard label (e.g. "*.example.com").
+	// The wildcard character '*' must appear by itself as the first DNS
+	// label and matches only a single label.
+	// You cannot have a wildcard label by itself (e.g. Host == "*").
+	// Requests will be matched against the Host field in the following order:
+	// 1. If Host is precise, the request matches this rule if
+	//    the http host header is equal to Host.
+	// 2. If Host is a wildcard, then the request matches this rule if
+	//    the http host header is to equal to the suffix
+	//    (removing the first label) of the wildcard rule.
+	//
+	// Support: Core
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Hostnames []HTTPRouteHostname `json:"hostnames,omitempty"`
+
+	// TLS defines the TLS certificate to use for Hostnames defined in this
+	// Route. This configuration only takes effect if the AllowRouteOverride
+	// field is set to true in the associated Gateway resource.
+	//
+	// Collisions can happen if multiple HTTPRoutes define a TLS certificate
+	// for the same hostname. In such a case, conflict resolution guiding
+	// principles apply, specificallly, if hostnames are same and two different
+	// certificates are specified then the certificate in the
+	// oldest resource wins.
+	//
+	// Please note that HTTP Route-selection takes place after the
+	// TLS Handshake (ClientHello). Due to this, TLS certificate defined
+	// here will take precedence even if the request has the potential to
+	// match multiple routes (in case multiple HTTPRoutes share the same
+	// hostname).
+	//
+	// Support: Core
+	//
+	// +optional
+	TLS *RouteTLSConfig `json:"tls,omitempty"`
+
+	// Rules are a list of HTTP matchers, filters and actions.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Rules []HTTPRouteRule `json:"rules"`
+}
+
+// RouteTLSConfig describes a TLS configuration defined at the Route level.
+type RouteTLSConfig struct {
+	// CertificateRef refers to a Kubernetes object that
+	// contains a TLS certificate and private key.
+	// This certificate MUST be used for TLS handshakes for the domain
+	// this RouteTLSConfig is associated with.
+	// If an entry in this list omits or specifies the empty
+	// string for both the group and the resource, the resource defaults to "secrets".
+	// An implementation may support other resources (for example, resource
+	// "mycertificates" in group "networking.acme.io").
+	//
+	// If the referent is in another namespace and no ReferenceGrant allows
+	// the reference, the certificate must not be used, and the controller
+	// should set the "ResolvedRefs" condition on the route to false with
+	// the "RefNotPermitted" reason.
+	//
+	// Support: Core (Kubernetes Secrets)
+	// Support: Implementation-specific (Other resource types)
+	//
+	// +required
+	CertificateRef CertificateObjectReference `json:"certificateRef"`
+}
+
+// HTTPRouteHostname is used to specify a hostname that should be matched by
+// HTTPRoute.
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=253
+type HTTPRouteHostname string
+
+// HTTPRouteRule defines semantics for matching an incoming HTTP request against
+// a set of matching rules and executing an action (and optionally filters) on
+// the request.
+type HTTPRouteRule struct {
+	// Matches define conditions used for matching the rule against
+	// incoming HTTP requests.
+	// Each match is independent, i.e. this rule will be matched
+	// if **any** one of the matches is satisfied.
+	//
+	// For example, take the following matches configuration:
+	//
+	// ```
+	// matches:
+	// - path:
+	//     value: "/foo"
+	//   headers:
+	//     values:
+	//       version: "2"
+	// - path:
+	//     value: "/v2/foo"
+	// ```
+	//
+	// For a request to match against this rule, a request should satisfy
+	// EITHER of the two conditions:
+	//
+	// - path prefixed with `/foo` AND contains the header `version: "2"`
+	// - path prefix of `/v2/foo`
+	//
+	// See the documentation for HTTPRouteMatch on how to specify multiple
+	// match conditions that should be ANDed together.
+	//
+	// If no matches are specified, the default is a prefix
+	// path match on "/", which has the effect of matching every
+	// HTTP request.
+	//
+	// +kubebuilder:default={{path:{ type: "Prefix", value: "/"}}}
+	// +kubebuilder:validation:MaxItems=8
+	Matches []HTTPRouteMatch `json:"matches,omitempty"`
+
+	// Filters define the filters that are applied to requests that match
+	// this rule.
+	//
+	// The effects of ordering of multiple behaviors are currently undefined.
+	// This can change in the future based on feedback during the alpha stage.
+	//
+	// Conformance-levels at this level are defined based on the type of filter:
+	// - ALL core filters MUST be supported by all implementations.
+	// - Implementers are encouraged to support extended filters.
+	// - Implementation-specific custom filters have no API guarantees across implementations.
+	// Specifying a core filter multiple times has undefined or custom conformance.
+	//
+	// Support: core
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Filters []HTTPRouteFilter `json:"filters,omitempty"`
+
+	// ForwardTo defines the backend(s) where matching requests should be sent.
+	// +optional
+	// +kubebuilder:validation:MaxItems=4
+	ForwardTo []HTTPRouteForwardTo `json:"forwardTo,omitempty"`
+
+	// Timeouts defines the timeouts that apply to requests matching this
+	// rule. If unspecified, timeouts are implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	Timeouts *HTTPRouteTimeouts `json:"timeouts,omitempty"`
+
+	// Retry defines how requests matching this rule are retried when the
+	// backend fails to respond successfully. If unspecified, requests are
+	// not retried.
+	//
+	// Support: Extended
+	//
+	// +optional
+	Retry *HTTPRetryPolicy `json:"retry,omitempty"`
+}
+
+// HTTPRouteTimeouts defines the timeouts that can be configured for an
+// HTTP request. Durations are specified in the format accepted by Go's
+// time.ParseDuration, e.g. "10s" or "1m30s", must be positive and may not
+// exceed one hour.
+//
+// Request must not be shorter than BackendRequest.
+type HTTPRouteTimeouts struct {
+	// Request specifies the maximum duration for the gateway to respond to
+	// an HTTP request, from the time the request is received until the
+	// response is sent, including all retries.
+	//
+	// Support: Extended
+	//
+	// +optional
+	Request *metav1.Duration `json:"request,omitempty"`
+
+	// BackendRequest specifies the maximum duration of a single request
+	// from the gateway to a backend, from the time the request is sent
+	// until the response is received. When retries are configured, this
+	// applies to the whole sequence of attempts and PerTryTimeout applies
+	// to each attempt.
+	//
+	// Support: Extended
+	//
+	// +optional
+	BackendRequest *metav1.Duration `json:"backendRequest,omitempty"`
+}
+
+// HTTPRetryPolicy defines when and how often a request is retried.
+//
+// For example, the following retries a request up to 3 times when the
+// backend cannot be reached or responds with a 503, waiting between 25ms
+// and 250ms between attempts:
+//
+// ```
+// retry:
+//   attempts: 3
+//   perTryTimeout: 2s
+//   retryOn:
+//     statusCodes: [503]
+//     connectFailure: true
+//   backoff:
+//     baseInterval: 25ms
+//     maxInterval: 250ms
+// ```
+type HTTPRetryPolicy struct {
+	// Attempts is the maximum number of times a request is retried, not
+	// counting the initial request.
+	//
+	// Support: Extended
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	Attempts int32 `json:"attempts"`
+
+	// PerTryTimeout specifies the maximum duration of each attempt,
+	// including the initial request. It must not be longer than the
+	// Request and BackendRequest timeouts of the rule.
+	//
+	// Support: Extended
+	//
+	// +optional
+	PerTryTimeout *metav1.Duration `json:"perTryTimeout,omitempty"`
+
+	// RetryOn defines the failures that cause a request to be retried.
+	// At least one condition must be specified.
+	//
+	// Support: Extended
+	RetryOn HTTPRetryOn `json:"retryOn"`
+
+	// Backoff defines the interval between attempts. If unspecified, the
+	// interval is implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	Backoff *HTTPRetryBackoff `json:"backoff,omitempty"`
+}
+
+// HTTPRetryOn defines the failures that cause a request to be retried.
+type HTTPRetryOn struct {
+	// StatusCodes retries requests whose response has one of the listed
+	// HTTP status codes.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	StatusCodes []HTTPRetryStatusCode `json:"statusCodes,omitempty"`
+
+	// ConnectFailure retries requests when a connection to the backend
+	// cannot be established, including connection timeouts and resets
+	// before the request was sent.
+	//
+	// Support: Extended
+	//
+	// +optional
+	ConnectFailure bool `json:"connectFailure,omitempty"`
+}
+
+// HTTPRetryStatusCode is an HTTP status code that causes a request to be
+// retried. Only 4xx and 5xx codes are accepted.
+//
+// +kubebuilder:validation:Minimum=400
+// +kubebuilder:validation:Maximum=599
+type HTTPRetryStatusCode int32
+
+// HTTPRetryBackoff defines an exponential backoff between attempts,
+// starting at BaseInterval and capped at MaxInterval. Durations are
+// specified in the format accepted by Go's time.ParseDuration.
+type HTTPRetryBackoff struct {
+	// BaseInterval is the interval before the first retry.
+	//
+	// Support: Extended
+	BaseInterval metav1.Duration `json:"baseInterval"`
+
+	// MaxInterval is the maximum interval between attempts. It must not be
+	// shorter than BaseInterval. If unspecified, it is ten times
+	// BaseInterval.
+	//
+	// Support: Extended
+	//
+	// +optional
+	MaxInterval *metav1.Duration `json:"maxInterval,omitempty"`
+}
+
+// PathMatchType specifies the semantics of how HTTP paths should be compared.
+// Valid PathMatchType values are:
+//
+// * "Exact"
+// * "Prefix"
+// * "RegularExpression"
+// * "ImplementationSpecific"
+//
+// +kubebuilder:validation:Enum=Exact;Prefix;RegularExpression;ImplementationSpecific
+type PathMatchType string
+
+// PathMatchType constants.
+const (
+	PathMatchExact                  PathMatchType = "Exact"
+	PathMatchPrefix                 PathMatchType = "Prefix"
+	PathMatchRegularExpression      PathMatchType = "RegularExpression"
+	PathMatchImplementationSpecific PathMatchType = "ImplementationSpecific"
+)
+
+// HeaderMatchType specifies the semantics of how HTTP headers should be compared.
+// Valid HeaderMatchType values are:
+//
+// * "Exact"
+// * "RegularExpression"
+// * "Present"
+// * "Absent"
+// * "ImplementationSpecific"
+//
+// +kubebuilder:validation:Enum=Exact;RegularExpression;Present;Absent;ImplementationSpecific
+type HeaderMatchType string
+
+// HeaderMatchType constants.
+const (
+	// HeaderMatchTypeExact matches HTTP request-header fields.
+	// Field name matches are case-insensitive while field value matches
+	// are case-sensitive.
+	HeaderMatchExact HeaderMatchType = "Exact"
+
+	// HeaderMatchRegularExpression matches HTTP request-header fields
+	// whose value matches a regular expression.
+	HeaderMatchRegularExpression HeaderMatchType = "RegularExpression"
+
+	// HeaderMatchPresent matches requests that contain the header,
+	// regardless of its value. It can only be used by HTTPHeaderMatchers.
+	HeaderMatchPresent HeaderMatchType = "Present"
+
+	// HeaderMatchAbsent matches requests that do not contain the header.
+	// It can only be used by HTTPHeaderMatchers.
+	HeaderMatchAbsent HeaderMatchType = "Absent"
+
+	HeaderMatchImplementationSpecific HeaderMatchType = "ImplementationSpecific"
+)
+
+// HTTPPathMatch describes how to select a HTTP route by matching the HTTP request path.
+type HTTPPathMatch struct {
+	// Type specifies how to match against the path Value.
+	//
+	// Support: core (Exact, Prefix)
+	// Support: custom (RegularExpression, ImplementationSpecific)
+	//
+	// Since RegularExpression PathType has custom conformance, implementations
+	// can support POSIX, PCRE or any other dialects of regular expressions.
+	// Please read the implementation's documentation to determine the supported
+	// dialect.
+	//
+	// Default: "Prefix"
+	//
+	// +kubebuilder:default=Prefix
+	Type PathMatchType `json:"type,omitempty"`
+
+	// Value of the HTTP path to match against.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
+
+// HTTPHeaderMatch describes how to select a HTTP route by matching HTTP request headers.
+//
+// Headers can be matched with the Values map, which applies a single
+// match type to every header, or with the Matchers list, which gives each
+// header its own match type. Values and Matchers can be combined, and all
+// of their conditions are ANDed together.
+//
+// For example, the match below selects requests that carry a "canary"
+// cookie, come from a mobile user agent, and have no "x-debug" header:
+//
+// ```
+// headers:
+//   matchers:
+//   - name: cookie
+//     type: RegularExpression
+//     value: ".*canary=true.*"
+//   - name: user-agent
+//     type: RegularExpression
+//     value: ".*(Android|iPhone).*"
+//   - name: x-debug
+//     type: Absent
+// ```
+type HTTPHeaderMatch struct {
+	// HeaderMatchType specifies how to match a HTTP request
+	// header against the Values map. Present and Absent cannot be used
+	// with the Values map.
+	//
+	// Support: core (Exact)
+	// Support: custom (RegularExpression, ImplementationSpecific)
+	//
+	// Default: "Exact"
+	//
+	// +kubebuilder:default=Exact
+	Type HeaderMatchType `json:"type,omitempty"`
+
+	// Values is a map of HTTP Headers to be matched.
+	// Values and Matchers together MUST contain at least one entry.
+	//
+	// The HTTP header field name to match is the map key, and the
+	// value of the HTTP header is the map value. HTTP header field
+	// names MUST be matched case-insensitively.
+	//
+	// Multiple match values are ANDed together, meaning, a request
+	// must match all the specified headers to select the route.
+	//
+	// +optional
+	Values map[string]string `json:"values,omitempty"`
+
+	// Matchers is a list of HTTP header matchers, each with its own
+	// match type. Multiple matchers are ANDed together, meaning, a
+	// request must match all the matchers to select the route.
+	//
+	// Support: extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Matchers []HTTPHeaderMatcher `json:"matchers,omitempty"`
+}
+
+// HTTPHeaderMatcher describes how to match a single HTTP request header.
+type HTTPHeaderMatcher struct {
+	// Name is the name of the HTTP header to match. HTTP header field
+	// names MUST be matched case-insensitively.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// Type specifies how to match the HTTP header against Value.
+	//
+	// Support: core (Exact)
+	// Support: extended (Present, Absent)
+	// Support: custom (RegularExpression, ImplementationSpecific)
+	//
+	// Since RegularExpression has custom conformance, implementations
+	// can support POSIX, PCRE or any other dialects of regular expressions.
+	// Please read the implementation's documentation to determine the
+	// supported dialect.
+	//
+	// Default: "Exact"
+	//
+	// +kubebuilder:default=Exact
+	Type HeaderMatchType `json:"type,omitempty"`
+
+	// Value is the value of the HTTP header to match, or the regular
+	// expression the whole value must match. It MUST be set for the
+	// Exact and RegularExpression types, and MUST NOT be set for the
+	// Present and Absent types. If the header is repeated in the request,
+	// the matcher selects the request if any of its values matches.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	Value string `json:"value,omitempty"`
+}
+
+// MethodMatchType specifies the semantics of how HTTP methods should be
+// compared.
+// Valid MethodMatchType values are:
+//
+// * "Exact"
+// * "RegularExpression"
+//
+// +kubebuilder:validation:Enum=Exact;RegularExpression
+type MethodMatchType string
+
+// MethodMatchType constants.
+const (
+	MethodMatchExact             MethodMatchType = "Exact"
+	MethodMatchRegularExpression MethodMatchType = "RegularExpression"
+)
+
+// QueryParamMatchType specifies the semantics of how HTTP query parameters
+// should be compared.
+// Valid QueryParamMatchType values are:
+//
+// * "Exact"
+// * "RegularExpression"
+//
+// +kubebuilder:validation:Enum=Exact;RegularExpression
+type QueryParamMatchType string
+
+// QueryParamMatchType constants.
+const (
+	QueryParamMatchExact             QueryParamMatchType = "Exact"
+	QueryParamMatchRegularExpression QueryParamMatchType = "RegularExpression"
+)
+
+// HTTPMethodMatch describes how to select a HTTP route by matching the HTTP
+// request method.
+type HTTPMethodMatch struct {
+	// Type specifies how to match against the method Value.
+	//
+	// Support: extended (Exact)
+	// Support: custom (RegularExpression)
+	//
+	// Since RegularExpression has custom conformance, implementations
+	// can support POSIX, PCRE or any other dialects of regular expressions.
+	// Please read the implementation's documentation to determine the
+	// supported dialect.
+	//
+	// Default: "Exact"
+	//
+	// +kubebuilder:default=Exact
+	Type MethodMatchType `json:"type,omitempty"`
+
+	// Value of the HTTP method to match against. HTTP methods are
+	// case-sensitive [RFC-7231 4.1], so "get" does not match a GET
+	// request.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Value string `json:"value"`
+}
+
+// HTTPQueryParamMatch describes how to select a HTTP route by matching HTTP
+// query parameters.
+type HTTPQueryParamMatch struct {
+	// Type specifies how to match a HTTP request query parameter
+	// against the Values map.
+	//
+	// Support: extended (Exact)
+	// Support: custom (RegularExpression)
+	//
+	// Default: "Exact"
+	//
+	// +kubebuilder:default=Exact
+	Type QueryParamMatchType `json:"type,omitempty"`
+
+	// Values is a map of HTTP query parameters to be matched.
+	// It MUST contain at least one entry.
+	//
+	// The query parameter name to match is the map key, and the value
+	// of the query parameter is the map value. Query parameter names
+	// are matched case-sensitively. If a query parameter is repeated in
+	// the request, only its first value is matched.
+	//
+	// Multiple match values are ANDed together, meaning, a request
+	// must match all the specified query parameters to select the route.
+	Values map[string]string `json:"values"`
+}
+
+// HTTPRouteMatch defines the predicate used to match requests to a given
+// action. Multiple match types are ANDed together, i.e. the match will
+// evaluate to true only if all conditions are satisfied.
+//
+// For example, the match below will match a HTTP request only if its path
+// starts with `/foo` AND it contains the `version: "1"` header:
+//
+// ```
+// match:
+//   path:
+//     value: "/foo"
+//   headers:
+//     values:
+//       version: "1"
+// ```
+//
+// The method and query parameters of a request can be matched too. The
+// match below selects `POST /orders?version=beta`:
+//
+// ```
+// match:
+//   path:
+//     value: "/orders"
+//   method:
+//     value: POST
+//   queryParams:
+//     values:
+//       version: beta
+// ```
+type HTTPRouteMatch struct {
+	// Path specifies a HTTP request path matcher. If this field is not
+	// specified, a default prefix match on the "/" path is provided.
+	//
+	// +kubebuilder:default={type: "Prefix", value: "/"}
+	Path HTTPPathMatch `json:"path,omitempty"`
+
+	// Headers specifies a HTTP request header matcher.
+	//
+	// +optional
+	Headers *HTTPHeaderMatch `json:"headers"`
+
+	// Method specifies a HTTP request method matcher. If this field is
+	// not specified, requests of any method are matched.
+	//
+	// Support: extended
+	//
+	// +optional
+	Method *HTTPMethodMatch `json:"method,omitempty"`
+
+	// QueryParams specifies a HTTP request query parameter matcher.
+	//
+	// Support: extended
+	//
+	// +optional
+	QueryParams *HTTPQueryParamMatch `json:"queryParams,omitempty"`
+
+	// ExtensionRef is an optional, implementation-specific extension to the
+	// "match" behavior.  The resource may be "configmap" (use the empty
+	// string for the group) or an implementation-defined resource (for
+	// example, resource "myroutematchers" in group "networking.acme.io").
+	// Omitting or specifying the empty string for both the resource and
+	// group indicates that the resource is "configmaps".
+	//
+	// If the referent cannot be found, the route must be dropped
+	// from the Gateway. The controller should raise the "ResolvedRefs"
+	// condition on the Gateway with the "DroppedRoutes" reason.
+	// The gateway status for this route should be updated with a
+	// condition that describes the error more specifically.
+	//
+	// Support: custom
+	//
+	// +optional
+	ExtensionRef *LocalObjectReference `json:"extensionRef,omitempty"`
+}
+
+const (
+	// FilterTypeHTTPRequestHeader can be used to set, add or remove an HTTP
+	// header from an HTTP request before it is sent to the upstream target.
+	// Support: core
+	FilterTypeHTTPRequestHeader = "RequestHeader"
+
+	// FilterTypeHTTPResponseHeader can be used to set, add or remove an
+	// HTTP header from an HTTP response before it is sent to the client.
+	// Support: extended
+	FilterTypeHTTPResponseHeader = "ResponseHeader"
+
+	// FilterTypeHTTPRequestMirror can be used to mirror requests to a
+	// different backend. The responses from this backend MUST be ignored
+	// by the Gateway.
+	// Support: extended
+	FilterTypeHTTPRequestMirror = "RequestMirror"
+
+	// FilterTypeHTTPRequestRedirect can be used to redirect a request to
+	// another location. The request is not forwarded to a backend; the
+	// Gateway responds with a redirect instead.
+	// It can only be used in the filters of a rule without forwardTo
+	// targets.
+	// Support: extended
+	FilterTypeHTTPRequestRedirect = "RequestRedirect"
+
+	// FilterTypeURLRewrite can be used to modify the path or the host of
+	// a request before it is forwarded to the upstream target.
+	// Support: extended
+	FilterTypeURLRewrite = "URLRewrite"
+
+	// FilterTypeImplementationSpecific should be used for configuring
+	// custom filters.
+	FilterTypeImplementationSpecific = "ImplementationSpecific"
+)
+
+// HTTPRouteFilter defines additional processing steps that must be completed
+// during the request or response lifecycle.
+// HTTPRouteFilters are meant as an extension point to express additional
+// processing that may be done in Gateway implementations. Some examples include
+// request or response modification, implementing authentication strategies,
+// rate-limiting, and traffic shaping.
+// API guarantee/conformance is defined based on the type of the filter.
+// TODO(hbagdi): re-render CRDs once controller-tools supports union tags:
+// - https://github.com/kubernetes-sigs/controller-tools/pull/298
+// - https://github.com/kubernetes-sigs/controller-tools/issues/461
+// +union
+type HTTPRouteFilter struct {
+	// Type identifies the filter to execute.
+	// Types are classified into three conformance-levels (similar to
+	// other locations in this API):
+	// - Core and extended: These filter types and their corresponding configuration
+	//   is defined in this package. All implementations must implement
+	//   the core filters. Implementers are encouraged to support extended filters.
+	//   Definitions for filter-specific configuration for these
+	//   filters is defined in this package.
+	// - Custom: These filters are defined and supported by specific vendors.
+	//   In the future, filters showing convergence in behavior across multiple
+	//   implementations will be considered for inclusion in extended or core
+	//   conformance rings. Filter-specific configuration for such filters
+	//   is specified using the ExtensionRef field. `Type` should be set to
+	//   "ImplementationSpecific" for custom filters.
+	//
+	// Implementers are encouraged to define custom implementation
+	// types to extend the core API with implementation-specific behavior.
+	//
+	// +unionDiscriminator
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=100
+	Type string `json:"type"`
+
+	// ExtensionRef is an optional, implementation-specific extension to the
+	// "filter" behavior.  The resource may be "configmap" (use the empty
+	// string for the group) or an implementation-defined resource (for
+	// example, resource "myroutefilters" in group "networking.acme.io").
+	// Omitting or specifying the empty string for both the resource and
+	// group indicates that the resource is "configmaps".
+	// ExtensionRef MUST NOT be used for core and extended filters.
+	// +optional
+	ExtensionRef *LocalObjectReference `json:"extensionRef,omitempty"`
+
+	// Filter-specific configuration definitions for core and extended filters
+
+	// +optional
+	RequestHeader *HTTPRequestHeaderFilter `json:"requestHeader,omitempty"`
+
+	// +optional
+	ResponseHeader *HTTPResponseHeaderFilter `json:"responseHeader,omitempty"`
+
+	// +optional
+	RequestMirror *HTTPRequestMirrorFilter `json:"requestMirror,omitempty"`
+
+	// +optional
+	RequestRedirect *HTTPRequestRedirectFilter `json:"requestRedirect,omitempty"`
+
+	// +optional
+	URLRewrite *HTTPURLRewriteFilter `json:"urlRewrite,omitempty"`
+}
+
+// HTTPRequestHeaderFilter defines configuration for the
+// RequestHeader filter.
+type HTTPRequestHeaderFilter struct {
+	// Set overwrites the request with the given header (name, value)
+	// before the action. Existing values of the header are replaced.
+	//
+	// Input:
+	//   GET /foo HTTP/1.1
+	//   my-header: foo
+	//
+	// Config:
+	//   set: {"my-header": "bar"}
+	//
+	// Output:
+	//   GET /foo HTTP/1.1
+	//   my-header: bar
+	//
+	// Support: Extended
+	//
+	// +optional
+	Set map[string]string `json:"set,omitempty"`
+
+	// Add adds the given header (name, value) to the request
+	// before the action. If the header is already present, the value
+	// is appended to its existing values.
+	//
+	// Input:
+	//   GET /foo HTTP/1.1
+	//   my-header: foo
+	//
+	// Config:
+	//   add: {"my-header": "bar"}
+	//
+	// Output:
+	//   GET /foo HTTP/1.1
+	//   my-header: foo
+	//   my-header: bar
+	//
+	// Support: Extended
+	//
+	// +optional
+	Add map[string]string `json:"add,omitempty"`
+
+	// Remove the given header(s) from the HTTP request before the
+	// action. The value of RemoveHeader is a list of HTTP header
+	// names. Note that the header names are case-insensitive
+	// [RFC-2616 4.2].
+	//
+	// Input:
+	//   GET /foo HTTP/1.1
+	//   My-Header1: ABC
+	//   My-Header2: DEF
+	//   My-Header2: GHI
+	//
+	// Config:
+	//   remove: ["my-header1", "my-header3"]
+	//
+	// Output:
+	//   GET /foo HTTP/1.1
+	//   My-Header2: DEF
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Remove []string `json:"remove,omitempty"`
+}
+
+// HTTPResponseHeaderFilter defines configuration for the ResponseHeader
+// filter. The operations are applied to the response received from the
+// upstream target before it is returned to the client. When the filter is
+// specified for a ForwardTo target, it only applies to responses from
+// that target.
+type HTTPResponseHeaderFilter struct {
+	// Set overwrites the response with the given header (name, value).
+	// Existing values of the header are replaced.
+	//
+	// Input:
+	//   HTTP/1.1 200 OK
+	//   Server: nginx/1.19
+	//
+	// Config:
+	//   set: {"server": "gateway"}
+	//
+	// Output:
+	//   HTTP/1.1 200 OK
+	//   Server: gateway
+	//
+	// Support: Extended
+	//
+	// +optional
+	Set map[string]string `json:"set,omitempty"`
+
+	// Add adds the given header (name, value) to the response. If the
+	// header is already present, the value is appended to its existing
+	// values.
+	//
+	// Input:
+	//   HTTP/1.1 200 OK
+	//
+	// Config:
+	//   add: {"strict-transport-security": "max-age=31536000"}
+	//
+	// Output:
+	//   HTTP/1.1 200 OK
+	//   Strict-Transport-Security: max-age=31536000
+	//
+	// Support: Extended
+	//
+	// +optional
+	Add map[string]string `json:"add,omitempty"`
+
+	// Remove the given header(s) from the response. The value of Remove
+	// is a list of HTTP header names, which are case-insensitive
+	// [RFC-2616 4.2].
+	//
+	// Input:
+	//   HTTP/1.1 200 OK
+	//   Server: nginx/1.19
+	//   X-Powered-By: PHP
+	//
+	// Config:
+	//   remove: ["server", "x-powered-by"]
+	//
+	// Output:
+	//   HTTP/1.1 200 OK
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Remove []string `json:"remove,omitempty"`
+}
+
+// HTTPRequestMirrorFilter defines configuration for the RequestMirror filter.
+type HTTPRequestMirrorFilter struct {
+	// ServiceName refers to the name of the Service to mirror matched requests
+	// to. When specified, this takes the place of BackendRef. If both
+	// BackendRef and ServiceName are specified, ServiceName will be given
+	// precedence.
+	//
+	// If the referent cannot be found, the route must be dropped
+	// from the Gateway. The controller should raise the "ResolvedRefs"
+	// condition on the Gateway with the "DroppedRoutes" reason.
+	// The gateway status for this route should be updated with a
+	// condition that describes the error more specifically.
+	//
+	// Support: Core
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	ServiceName *string `json:"serviceName,omitempty"`
+
+	// BackendRef is a local object reference to mirror matched requests to. If
+	// both BackendRef and ServiceName are specified, ServiceName will be given
+	// precedence.
+	//
+	// If the referent cannot be found, the route must be dropped
+	// from the Gateway. The controller should raise the "ResolvedRefs"
+	// condition on the Gateway with the "DroppedRoutes" reason.
+	// The gateway status for this route should be updated with a
+	// condition that describes the error more specifically.
+	//
+	// Support: Custom
+	//
+	// +optional
+	BackendRef *LocalObjectReference `json:"backendRef,omitempty"`
+
+	// Port specifies the destination port number to use for the
+	// backend referenced by the ServiceName or BackendRef field.
+	//
+	// If the port is unspecified and a Service object consisting
+	// of a single port definition is the backend, that port will
+	// be used.
+	//
+	// If the port is unspecified and the backend is a Service
+	// object consisting of multiple port definitions, the route
+	// must be dropped from the Gateway. The controller should
+	// raise the "ResolvedRefs" condition on the Gateway with the
+	// "DroppedRoutes" reason.  The gateway status for this route
+	// should be updated with a condition that describes the error
+	// more specifically.
+	//
+	// Support: Core
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+}
+
+// HTTPRequestRedirectFilter defines configuration for the RequestRedirect
+// filter. Fields that are not specified are taken from the original
+// request.
+//
+// When a RequestRedirect filter applies to a request, the Gateway responds
+// with the redirect and the request is not forwarded to any backend.
+type HTTPRequestRedirectFilter struct {
+	// Scheme is the scheme to be used in the value of the `Location`
+	// header in the response.
+	//
+	// Input:
+	//   GET http://example.com/foo
+	//
+	// Config:
+	//   scheme: HTTPS
+	//
+	// Output:
+	//   HTTP/1.1 302 Found
+	//   Location: https://example.com/foo
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	Scheme *string `json:"scheme,omitempty"`
+
+	// Hostname is the hostname to be used in the value of the `Location`
+	// header in the response.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Port is the port to be used in the value of the `Location`
+	// header in the response. When the port is unspecified and Scheme is
+	// set, the well-known port of the scheme (80 for HTTP, 443 for HTTPS)
+	// is used and omitted from the `Location` header. Otherwise the port
+	// of the original request is used.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
+	// Path replaces the full path of the request in the value of the
+	// `Location` header in the response. The query string of the
+	// original request is preserved.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/`
+	Path *string `json:"path,omitempty"`
+
+	// StatusCode is the HTTP status code to be used in the response.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:default=302
+	// +kubebuilder:validation:Enum=301;302
+	StatusCode *int32 `json:"statusCode,omitempty"`
+}
+
+// HTTPURLRewriteFilter defines configuration for the URLRewrite filter.
+// The rewritten request is forwarded to the upstream target; the client
+// is not redirected.
+type HTTPURLRewriteFilter struct {
+	// Hostname is the value to replace the `Host` header of the request
+	// with.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Path defines how the path of the request is rewritten.
+	//
+	// Support: Extended
+	//
+	// +optional
+	Path *HTTPPathModifier `json:"path,omitempty"`
+}
+
+// HTTPPathModifierType defines the type of path modification.
+// Valid HTTPPathModifierType values are:
+//
+// * "ReplaceFullPath"
+// * "ReplacePrefixMatch"
+//
+// +kubebuilder:validation:Enum=ReplaceFullPath;ReplacePrefixMatch
+type HTTPPathModifierType string
+
+// HTTPPathModifierType constants.
+const (
+	// FullPathHTTPPathModifier replaces the full path of the request.
+	FullPathHTTPPathModifier HTTPPathModifierType = "ReplaceFullPath"
+
+	// PrefixMatchHTTPPathModifier replaces the part of the path matched
+	// by a Prefix path match.
+	PrefixMatchHTTPPathModifier HTTPPathModifierType = "ReplacePrefixMatch"
+)
+
+// HTTPPathModifier defines how a path is modified. Exactly one of
+// ReplaceFullPath and ReplacePrefixMatch must be set, as selected by
+// Type.
+type HTTPPathModifier struct {
+	// Type defines the type of path modification.
+	//
+	// Support: Extended
+	Type HTTPPathModifierType `json:"type"`
+
+	// ReplaceFullPath replaces the full path of the request. The query
+	// string of the request is preserved.
+	//
+	// Input:
+	//   GET /api/v2/orders/123?page=2
+	//
+	// Config:
+	//   replaceFullPath: /orders
+	//
+	// Output:
+	//   GET /orders?page=2
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/`
+	ReplaceFullPath *string `json:"replaceFullPath,omitempty"`
+
+	// ReplacePrefixMatch replaces the prefix of the path that was matched
+	// by the Prefix path match of the rule. Prefixes are matched element
+	// by element, so the remainder of the path is either empty or starts
+	// with "/", and is appended to the replacement. A replacement of "/"
+	// followed by a remainder starting with "/" yields a single "/".
+	//
+	// Input:
+	//   GET /api/v2/orders/123
+	//
+	// Match:
+	//   path: {type: Prefix, value: /api/v2/orders}
+	//
+	// Config:
+	//   replacePrefixMatch: /
+	//
+	// Output:
+	//   GET /123
+	//
+	// ReplacePrefixMatch may only be used in rules whose matches are all
+	// Prefix path matches; a rule without matches matches the "/" prefix.
+	// Routes that use it with other path match types are invalid.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/`
+	ReplacePrefixMatch *string `json:"replacePrefixMatch,omitempty"`
+}
+
+// HTTPRouteForwardTo defines how a HTTPRoute should forward a request.
+type HTTPRouteForwardTo struct {
+	// ServiceName refers to the name of the Service to forward matched requests
+	// to. When specified, this takes the place of BackendRef. If both
+	// BackendRef and ServiceName are specified, ServiceName will be given
+	// precedence.
+	//
+	// If the referent cannot be found, the route must be dropped
+	// from the Gateway. The controller should raise the "ResolvedRefs"
+	// condition on the Gateway with the "DroppedRoutes" reason.
+	// The gateway status for this route should be updated with a
+	// condition that describes the error more specifically.
+	//
+	// Support: Core
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	ServiceName *string `json:"serviceName,omitempty"`
+
+	// BackendRef is a reference to a backend to forward matched requests to. If
+	// both BackendRef and ServiceName are specified, ServiceName will be given
+	// precedence.
+	//
+	// If the referent cannot be found, the route must be dropped
+	// from the Gateway. The controller should raise the "ResolvedRefs"
+	// condition on the Gateway with the "DroppedRoutes" reason.
+	// The gateway status for this route should be updated with a
+	// condition that describes the error more specifically.
+	//
+	// Support: Custom
+	//
+	// +optional
+	BackendRef *LocalObjectReference `json:"backendRef,omitempty"`
+
+	// Namespace is the namespace of the backend referenced by the
+	// ServiceName or BackendRef field. If unspecified, the namespace of
+	// the route is used.
+	//
+	// A backend in another namespace may only be referenced if a
+	// ReferenceGrant in that namespace allows references from this kind
+	// of route in the route's namespace. Otherwise, the reference must be
+	// treated as unresolved, and the controller should set the
+	// "ResolvedRefs" condition on the route to false with the
+	// "RefNotPermitted" reason.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Port specifies the destination port number to use for the
+	// backend referenced by the ServiceName or BackendRef field.
+	//
+	// If the port is unspecified and a Service object consisting
+	// of a single port definition is the backend, that port will
+	// be used.
+	//
+	// If the port is unspecified and the backend is a Service
+	// object consisting of multiple port definitions, the route
+	// must be dropped from the Gateway. The controller should
+	// raise the "ResolvedRefs" condition on the Gateway with the
+	// "DroppedRoutes" reason.  The gateway status for this route
+	// should be updated with a condition that describes the error
+	// more specifically.
+	//
+	// Support: Core
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
+	// Weight specifies the proportion of traffic forwarded to the backend
+	// referenced by the ServiceName or BackendRef field. computed as
+	// weight/(sum of all weights in this ForwardTo list). Weight is not a
+	// percentage and the sum of weights does not need to equal 100. If only one
+	// backend is specified, 100% of the traffic is forwarded to that backend.
+	// If unspecified, weight defaults to 1.
+	//
+	// Support: Core
+	//
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	Weight int32 `json:"weight,omitempty"`
+
+	// Filters defined at this-level should be executed if and only if the
+	// request is being forwarded to the backend defined here.
+	//
+	// Conformance: Filtering support, including core filters, is NOT guaranteed
+	// at this-level. Use Filters in HTTPRouteRule for portable filters across
+	// implementations.
+	//
+	// Support: Custom
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Filters []HTTPRouteFilter `json:"filters,omitempty"`
+}
+
+// RouteHostExtensionObjectReference identifies a route-host extension object
+// within a known namespace.
+//
+// +k8s:deepcopy-gen=false
+type RouteHostExtensionObjectReference LocalObjectReference
+
+// HTTPRouteStatus defines the observed state of HTTPRoute.
+type HTTPRouteStatus struct {
+	RouteStatus `json:",inline"`
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+// LocalObjectReference identifies an API object within a known namespace.
+type LocalObjectReference struct {
+	// Group is the group of the referent.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Group string `json:"group"`
+	// Kind is kind of the referent.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Kind string `json:"kind"`
+	// Name is the name of the referent.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// CertificateObjectReference identifies an object holding a TLS
+// certificate, optionally in another namespace than the referencing
+// object.
+type CertificateObjectReference struct {
+	LocalObjectReference `json:",inline"`
+
+	// Namespace is the namespace of the referent. If unspecified, the
+	// namespace of the referencing object is used.
+	//
+	// An object in another namespace may only be referenced if a
+	// ReferenceGrant in that namespace allows references from the kind and
+	// namespace of the referencing object.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Namespace *string `json:"namespace,omitempty"`
+}
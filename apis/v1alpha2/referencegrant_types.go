@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+
+// ReferenceGrant allows objects in other namespaces to reference objects
+// in the namespace of the ReferenceGrant. It is created by the owner of
+// the referenced namespace, so that a reference across namespaces is only
+// valid if both sides agree to it.
+//
+// For example, the following allows HTTPRoutes in the "team-a" namespace
+// to forward requests to the "accounts" Service in the namespace of the
+// ReferenceGrant:
+//
+// ```
+// spec:
+//   from:
+//   - group: networking.x-k8s.io
+//     kind: HTTPRoute
+//     namespace: team-a
+//   to:
+//   - group: core
+//     kind: Service
+//     name: accounts
+// ```
+//
+// A reference is allowed if any entry of From matches the referencing
+// object and any entry of To matches the referenced object.
+type ReferenceGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ReferenceGrantSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReferenceGrantList contains a list of ReferenceGrant
+type ReferenceGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReferenceGrant `json:"items"`
+}
+
+// ReferenceGrantSpec defines the references that are allowed.
+type ReferenceGrantSpec struct {
+	// From describes the objects that may reference the objects described
+	// in To.
+	//
+	// Support: Core
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	From []ReferenceGrantFrom `json:"from"`
+
+	// To describes the objects in the namespace of the ReferenceGrant that
+	// may be referenced.
+	//
+	// Support: Core
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	To []ReferenceGrantTo `json:"to"`
+}
+
+// ReferenceGrantFrom describes the objects that may reference objects in
+// the namespace of a ReferenceGrant.
+type ReferenceGrantFrom struct {
+	// Group is the group of the referencing objects, e.g.
+	// "networking.x-k8s.io".
+	//
+	// +kubebuilder:validation:MaxLength=253
+	Group string `json:"group"`
+
+	// Kind is the kind of the referencing objects, e.g. "HTTPRoute".
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the referencing objects.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Namespace string `json:"namespace"`
+}
+
+// ReferenceGrantTo describes the objects in the namespace of a
+// ReferenceGrant that may be referenced.
+type ReferenceGrantTo struct {
+	// Group is the group of the referenced objects. The empty string and
+	// "core" both select the core API group.
+	//
+	// +kubebuilder:validation:MaxLength=253
+	Group string `json:"group"`
+
+	// Kind is the kind of the referenced objects, e.g. "Service".
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Kind string `json:"kind"`
+
+	// Name is the name of the referenced object. If unspecified, all
+	// objects of the kind may be referenced.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+
+// UDPRoute is the Schema for the UDPRoute resource.
+type UDPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UDPRouteSpec   `json:"spec,omitempty"`
+	Status UDPRouteStatus `json:"status,omitempty"`
+}
+
+// UDPRouteRule is the configuration for a given rule.
+type UDPRouteRule struct {
+	// Matches defines which packets match this rule.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	Matches []UDPRouteMatch `json:"matches,omitempty"`
+
+	// ForwardTo defines the backend(s) where matching requests should be sent.
+	// +optional
+	// +kubebuilder:validation:MaxItems=4
+	ForwardTo []RouteForwardTo `json:"forwardTo,omitempty"`
+}
+
+// UDPRouteSpec defines the desired state of UDPRoute.
+type UDPRouteSpec struct {
+	// Rules are a list of UDP matchers and actions.
+	Rules []UDPRouteRule `json:"rules"`
+
+	// Gateways defines which Gateways can use this Route.
+	// +kubebuilder:default={allow: "SameNamespace"}
+	Gateways RouteGateways `json:"gateways,omitempty"`
+}
+
+// UDPRouteStatus defines the observed state of UDPRoute.
+type UDPRouteStatus struct {
+	RouteStatus `json:",inline"`
+}
+
+// UDPRouteMatch defines the predicate used to match packets to a
+// given action. A packet is selected only if it satisfies every
+// specified condition.
+//
+// When several matches select a packet, the match with the longest
+// source CIDR prefix takes precedence, then a match that specifies a
+// Port.
+type UDPRouteMatch struct {
+	// SourceCIDRs defines a set of IPv4 or IPv6 CIDR blocks, e.g.
+	// "10.0.0.0/8" or "2001:db8::/32", that should match against the
+	// client source address of the packet. The packet matches if its
+	// source address is in any of the blocks. If unspecified, packets
+	// from every source address match.
+	//
+	// Support: core
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty"`
+
+	// Port is the destination port of the packet. If unspecified, the
+	// match accepts packets on every port of the listeners the route is
+	// bound to. A match on a port that no bound listener serves never
+	// selects a packet.
+	//
+	// Support: core
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
+	// ExtensionRef is an optional, implementation-specific extension to the
+	// "match" behavior.  The resource may be "configmap" (use the empty
+	// string for the group) or an implementation-defined resource (for
+	// example, resource "myroutematchers" in group "networking.acme.io").
+	// Omitting or specifying the empty string for both the resource and
+	// group indicates that the resource is "configmaps".
+	//
+	// If the referent cannot be found, the route must be dropped
+	// from the Gateway. The controller should raise the "ResolvedRefs"
+	// condition on the Gateway with the "DroppedRoutes" reason.
+	// The gateway status for this route should be updated with a
+	// condition that describes the error more specifically.
+	//
+	// Support: custom
+	//
+	// +optional
+	ExtensionRef *LocalObjectReference `json:"extensionRef,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UDPRouteList contains a list of UDPRoute
+type UDPRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UDPRoute `json:"items"`
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// conversion-webhook serves the CustomResourceDefinition conversion
+// webhook for the networking.x-k8s.io API group. The API server calls it
+// to convert objects between the served versions and the v1alpha2 storage
+// version.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/apis/v1alpha2"
+)
+
+// newScheme returns a scheme with every served version of the API group.
+func newScheme() (*runtime.Scheme, error) {
+	s := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(s); err != nil {
+		return nil, fmt.Errorf("failed to add v1alpha1 scheme: %w", err)
+	}
+	if err := v1alpha2.AddToScheme(s); err != nil {
+		return nil, fmt.Errorf("failed to add v1alpha2 scheme: %w", err)
+	}
+	return s, nil
+}
+
+func newRootCommand() *cobra.Command {
+	server := &webhook.Server{}
+	var development bool
+
+	cmd := &cobra.Command{
+		Use:           "conversion-webhook",
+		Short:         "Serve the networking.x-k8s.io CRD conversion webhook",
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctrllog.SetLogger(zap.New(zap.UseDevMode(development)))
+
+			s, err := newScheme()
+			if err != nil {
+				return err
+			}
+			if err := server.InjectFunc(func(i interface{}) error {
+				_, err := inject.SchemeInto(s, i)
+				return err
+			}); err != nil {
+				return err
+			}
+
+			server.Register("/convert", &conversion.Webhook{})
+			return server.Start(signals.SetupSignalHandler())
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&server.Host, "host", "", "Address to listen on. Defaults to all addresses.")
+	flags.IntVar(&server.Port, "port", 9443, "Port to listen on.")
+	flags.StringVar(&server.CertDir, "cert-dir", "/tmp/k8s-webhook-server/serving-certs", "Directory containing the serving certificate and key.")
+	flags.StringVar(&server.CertName, "cert-name", "tls.crt", "Name of the serving certificate file in --cert-dir.")
+	flags.StringVar(&server.KeyName, "key-name", "tls.key", "Name of the serving key file in --cert-dir.")
+	flags.BoolVar(&development, "development", false, "Log in a human-readable format.")
+
+	return cmd
+}
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/topology"
+)
+
+func newDescribeCommand(o *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Show a resource together with the resources it is bound to",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "gateway NAME",
+		Short: "Show a Gateway, its listeners and the routes bound to each listener",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, namespace, err := fetch(cmd.Context(), cmd.ErrOrStderr(), o)
+			if err != nil {
+				return err
+			}
+			gw, err := res.FindGateway(namespace, args[0])
+			if err != nil {
+				return err
+			}
+			describeGateway(cmd.OutOrStdout(), res, gw)
+			return nil
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "route [KIND/]NAME",
+		Short: "Show a route and the Gateway listeners it is bound to or rejected by",
+		Long: "Show a route and the Gateway listeners it is bound to or rejected by.\n\n" +
+			"KIND is one of HTTPRoute, GRPCRoute, TCPRoute, TLSRoute or UDPRoute, and defaults to HTTPRoute.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, name, err := parseRouteArg(args[0])
+			if err != nil {
+				return err
+			}
+			res, namespace, err := fetch(cmd.Context(), cmd.ErrOrStderr(), o)
+			if err != nil {
+				return err
+			}
+			route, err := res.FindRoute(kind, namespace, name)
+			if err != nil {
+				return err
+			}
+			describeRoute(cmd.OutOrStdout(), res, route)
+			return nil
+		},
+	})
+
+	return cmd
+}
+
+// fetch loads a snapshot of the cluster, and warns on stderr about the
+// kinds that could not be listed.
+func fetch(ctx context.Context, stderr io.Writer, o *options) (*topology.Resources, string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	gw, kube, namespace, err := o.clients()
+	if err != nil {
+		return nil, "", err
+	}
+	res, err := topology.Fetch(ctx, gw, kube)
+	if err != nil {
+		return nil, "", err
+	}
+	kinds := make([]string, 0, len(res.Unlisted))
+	for kind := range res.Unlisted {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		fmt.Fprintf(stderr, "Warning: %s are unknown: %v\n", kind, res.Unlisted[kind])
+	}
+	return res, namespace, nil
+}
+
+// parseRouteArg splits a "KIND/NAME" argument. The kind is matched
+// case-insensitively.
+func parseRouteArg(arg string) (string, string, error) {
+	parts := strings.SplitN(arg, "/", 2)
+	if len(parts) == 1 {
+		return topology.KindHTTPRoute, parts[0], nil
+	}
+	for _, kind := range []string{topology.KindHTTPRoute, topology.KindGRPCRoute, topology.KindTCPRoute, topology.KindTLSRoute, topology.KindUDPRoute} {
+		if strings.EqualFold(parts[0], kind) {
+			return kind, parts[1], nil
+		}
+	}
+	return "", "", fmt.Errorf("unknown route kind %q", parts[0])
+}
+
+func describeGateway(w io.Writer, res *topology.Resources, gw *v1alpha1.Gateway) {
+	b := res.Bind(gw)
+
+	fmt.Fprintf(w, "Name:          %s\n", gw.Name)
+	fmt.Fprintf(w, "Namespace:     %s\n", gw.Namespace)
+	if b.Class != nil {
+		fmt.Fprintf(w, "GatewayClass:  %s (controller %s)\n", b.Class.Name, b.Class.Spec.Controller)
+	} else {
+		fmt.Fprintf(w, "GatewayClass:  %s (not found)\n", gw.Spec.GatewayClassName)
+	}
+
+	var addrs []string
+	for _, a := range gw.Status.Addresses {
+		addrs = append(addrs, a.Value)
+	}
+	fmt.Fprintf(w, "Addresses:     %s\n", orNone(strings.Join(addrs, ", ")))
+
+	fmt.Fprintf(w, "Conditions:\n")
+	printConditions(w, "  ", gw.Status.Conditions)
+
+	fmt.Fprintf(w, "Listeners:\n")
+	for _, lb := range b.Listeners {
+		l := lb.Listener
+		fmt.Fprintf(w, "  [%d] %s\n", lb.Index, describeListener(l))
+		fmt.Fprintf(w, "    Selects:     %s\n", describeSelector(l.Routes))
+
+		for _, ls := range gw.Status.Listeners {
+			if ls.Port == l.Port {
+				fmt.Fprintf(w, "    Conditions:\n")
+				printConditions(w, "      ", ls.Conditions)
+			}
+		}
+
+		fmt.Fprintf(w, "    Routes:\n")
+		if len(lb.Routes) == 0 {
+			fmt.Fprintf(w, "      <none>\n")
+		}
+		for _, route := range lb.Routes {
+			fmt.Fprintf(w, "      %s %s/%s\n", route.Kind, route.Namespace, route.Name)
+			if st := route.GatewayStatus(gw.Namespace, gw.Name); st != nil {
+				printConditions(w, "        ", st.Conditions)
+			}
+			printRules(w, "        ", res, route)
+		}
+
+		if len(lb.Rejected) > 0 {
+			fmt.Fprintf(w, "    Rejected Routes:\n")
+			for _, rej := range lb.Rejected {
+				fmt.Fprintf(w, "      %s %s/%s: %s: %s\n",
+					rej.Route.Kind, rej.Route.Namespace, rej.Route.Name, rej.Reason, rej.Message)
+			}
+		}
+	}
+}
+
+func describeRoute(w io.Writer, res *topology.Resources, route *topology.Route) {
+	fmt.Fprintf(w, "Name:       %s\n", route.Name)
+	fmt.Fprintf(w, "Namespace:  %s\n", route.Namespace)
+	fmt.Fprintf(w, "Kind:       %s\n", route.Kind)
+	fmt.Fprintf(w, "Hostnames:  %s\n", orNone(strings.Join(route.Hostnames, ", ")))
+
+	allow := route.Gateways.Allow
+	if allow == "" {
+		allow = v1alpha1.GatewayAllowSameNamespace
+	}
+	fmt.Fprintf(w, "Gateways:   %s\n", allow)
+	if allow == v1alpha1.GatewayAllowFromList {
+		for _, ref := range route.Gateways.GatewayRefs {
+			fmt.Fprintf(w, "  %s/%s\n", ref.Namespace, ref.Name)
+		}
+	}
+
+	fmt.Fprintf(w, "Rules:\n")
+	printRules(w, "  ", res, route)
+
+	fmt.Fprintf(w, "Listeners:\n")
+	attachments := res.Attachments(route)
+	if len(attachments) == 0 {
+		fmt.Fprintf(w, "  <none>\n")
+	}
+	for _, a := range attachments {
+		fmt.Fprintf(w, "  Gateway %s/%s [%d] %s\n", a.Gateway.Namespace, a.Gateway.Name, a.ListenerIndex, describeListener(a.Listener))
+		if a.Bound {
+			fmt.Fprintf(w, "    Bound\n")
+		} else {
+			fmt.Fprintf(w, "    Rejected: %s: %s\n", a.Reason, a.Message)
+		}
+	}
+
+	fmt.Fprintf(w, "Status:\n")
+	if len(route.Status.Gateways) == 0 {
+		fmt.Fprintf(w, "  <none>\n")
+	}
+	for _, st := range route.Status.Gateways {
+		fmt.Fprintf(w, "  Gateway %s/%s\n", st.GatewayRef.Namespace, st.GatewayRef.Name)
+		printConditions(w, "    ", st.Conditions)
+	}
+}
+
+func printRules(w io.Writer, indent string, res *topology.Resources, route *topology.Route) {
+	for i, rule := range route.Rules {
+		fmt.Fprintf(w, "%sRule %d:\n", indent, i)
+		if f := ruleRedirect(route, i); f != nil {
+			fmt.Fprintf(w, "%s  redirect %s\n", indent, describeRedirect(f))
+		} else if len(rule.ForwardTo) == 0 {
+			fmt.Fprintf(w, "%s  <no backends>\n", indent)
+		}
+		for _, backend := range rule.ForwardTo {
+			rb := res.ResolveBackend(route, backend)
+			state := "resolved"
+			if !rb.Resolved {
+				state = "unresolved: " + rb.Message
+			}
+			fmt.Fprintf(w, "%s  -> %s weight=%d (%s)\n", indent, backend, weight(backend.Weight), state)
+		}
+	}
+}
+
+// ruleRedirect returns the RequestRedirect filter of rule i of route, or
+// nil if the rule has none.
+func ruleRedirect(route *topology.Route, i int) *v1alpha1.HTTPRequestRedirectFilter {
+	hr, ok := route.Object.(*v1alpha1.HTTPRoute)
+	if !ok {
+		return nil
+	}
+	for _, f := range hr.Spec.Rules[i].Filters {
+		if f.Type == v1alpha1.FilterTypeHTTPRequestRedirect && f.RequestRedirect != nil {
+			return f.RequestRedirect
+		}
+	}
+	return nil
+}
+
+// describeRedirect describes a RequestRedirect filter by its status code
+// and the parts of the request that it replaces.
+func describeRedirect(f *v1alpha1.HTTPRequestRedirectFilter) string {
+	code := int32(http.StatusFound)
+	if f.StatusCode != nil {
+		code = *f.StatusCode
+	}
+	parts := []string{fmt.Sprint(code)}
+	if f.Scheme != nil {
+		parts = append(parts, "scheme="+*f.Scheme)
+	}
+	if f.Hostname != nil {
+		parts = append(parts, "hostname="+*f.Hostname)
+	}
+	if f.Port != nil {
+		parts = append(parts, fmt.Sprintf("port=%d", *f.Port))
+	}
+	if f.Path != nil {
+		parts = append(parts, "path="+*f.Path)
+	}
+	return strings.Join(parts, " ")
+}
+
+func printConditions(w io.Writer, indent string, conditions []metav1.Condition) {
+	if len(conditions) == 0 {
+		fmt.Fprintf(w, "%s<none>\n", indent)
+		return
+	}
+	for _, c := range conditions {
+		fmt.Fprintf(w, "%s%s=%s", indent, c.Type, c.Status)
+		if c.Reason != "" {
+			fmt.Fprintf(w, " (%s)", c.Reason)
+		}
+		if c.Message != "" {
+			fmt.Fprintf(w, ": %s", c.Message)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func describeListener(l v1alpha1.Listener) string {
+	s := fmt.Sprintf("%s port %d", l.Protocol, l.Port)
+	if l.Hostname.Name != "" {
+		s += fmt.Sprintf(" hostname %s %q", l.Hostname.Match, l.Hostname.Name)
+	}
+	return s
+}
+
+func describeSelector(s v1alpha1.RouteBindingSelector) string {
+	from := s.RouteNamespaces.From
+	if from == "" {
+		from = v1alpha1.RouteSelectSame
+	}
+	desc := fmt.Sprintf("%s from namespaces %s", s.Kind, from)
+	if from == v1alpha1.RouteSelectSelector {
+		desc += fmt.Sprintf(" (%s)", metav1.FormatLabelSelector(&s.RouteNamespaces.Selector))
+	}
+	if len(s.RouteSelector.MatchLabels) > 0 || len(s.RouteSelector.MatchExpressions) > 0 {
+		desc += fmt.Sprintf(" with labels %s", metav1.FormatLabelSelector(&s.RouteSelector))
+	}
+	return desc
+}
+
+// weight returns the effective weight of a backend. Weight defaults to
+// 1 when the object has not been defaulted by the API server.
+func weight(w int32) int32 {
+	if w == 0 {
+		return 1
+	}
+	return w
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/service-apis/pkg/graph"
+	"sigs.k8s.io/service-apis/pkg/manifest"
+	"sigs.k8s.io/service-apis/pkg/topology"
+)
+
+func newGraphCommand(o *options) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "graph [PATH...]",
+		Short: "Export the graph of Gateways, routes and backends",
+		Long: "Export the graph of GatewayClasses, Gateways, listeners, routes, rules\n" +
+			"and backends, with the BackendPolicies and Secrets they refer to.\n\n" +
+			"Each PATH is a YAML file or a directory of YAML files. Without PATH,\n" +
+			"the graph of the cluster is exported.",
+		Example: "  gwctl graph -o dot | dot -Tsvg > gateways.svg",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			write, ok := map[string]func(w io.Writer, g *graph.Graph) error{
+				"dot":     graph.WriteDOT,
+				"mermaid": graph.WriteMermaid,
+				"json":    graph.WriteJSON,
+			}[output]
+			if !ok {
+				return fmt.Errorf("unknown output format %q", output)
+			}
+
+			var res *topology.Resources
+			if len(args) > 0 {
+				objects, err := manifest.Load(args...)
+				if err != nil {
+					return err
+				}
+				res = &topology.Resources{}
+				for _, o := range objects {
+					res.Add(o.Object)
+				}
+			} else {
+				var err error
+				if res, _, err = fetch(cmd.Context(), cmd.ErrOrStderr(), o); err != nil {
+					return err
+				}
+			}
+
+			return write(cmd.OutOrStdout(), graph.Build(res))
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "dot", "Output format. One of: dot, mermaid, json.")
+
+	return cmd
+}
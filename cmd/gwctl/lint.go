@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/service-apis/pkg/lint"
+	"sigs.k8s.io/service-apis/pkg/manifest"
+)
+
+func newLintCommand() *cobra.Command {
+	var output string
+	var strict bool
+
+	cmd := &cobra.Command{
+		Use:   "lint PATH...",
+		Short: "Check manifests for problems between objects",
+		Long: "Check manifests for problems between objects, such as routes that no\n" +
+			"Gateway can bind and references to objects that do not exist.\n\n" +
+			"Each PATH is a YAML file or a directory of YAML files. The command\n" +
+			"fails if any error is found, or any warning with --strict.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			write, ok := map[string]func(w io.Writer, findings []lint.Finding) error{
+				"text":  lint.WriteText,
+				"json":  lint.WriteJSON,
+				"sarif": lint.WriteSARIF,
+			}[output]
+			if !ok {
+				return fmt.Errorf("unknown output format %q", output)
+			}
+
+			objects, err := manifest.Load(args...)
+			if err != nil {
+				return err
+			}
+
+			findings := lint.Run(objects)
+			if err := write(cmd.OutOrStdout(), findings); err != nil {
+				return err
+			}
+
+			if lint.HasErrors(findings) || (strict && len(findings) > 0) {
+				return errors.New("lint found problems")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "Output format. One of: text, json, sarif.")
+	cmd.Flags().BoolVar(&strict, "strict", false, "Fail on warnings as well as errors.")
+
+	return cmd
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// gwctl is a command line tool for inspecting service-apis resources and
+// the relationships between them.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"sigs.k8s.io/service-apis/pkg/client/clientset/versioned"
+)
+
+// options holds the flags shared by all commands that talk to a cluster.
+type options struct {
+	kubeconfig string
+	context    string
+	namespace  string
+}
+
+// clients builds the clientsets for the configured cluster, and returns
+// the namespace to operate in.
+func (o *options) clients() (versioned.Interface, kubernetes.Interface, string, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = o.kubeconfig
+
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: o.context}
+	overrides.Context.Namespace = o.namespace
+
+	config := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
+
+	restConfig, err := config.ClientConfig()
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("loading kubeconfig: %w", err)
+	}
+
+	namespace, _, err := config.Namespace()
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("determining namespace: %w", err)
+	}
+
+	gw, err := versioned.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	kube, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return gw, kube, namespace, nil
+}
+
+func newRootCommand() *cobra.Command {
+	o := &options{}
+
+	cmd := &cobra.Command{
+		Use:           "gwctl",
+		Short:         "Inspect service-apis resources and their relationships",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&o.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file.")
+	flags.StringVar(&o.context, "context", "", "The kubeconfig context to use.")
+	flags.StringVarP(&o.namespace, "namespace", "n", "", "The namespace to operate in.")
+
+	cmd.AddCommand(newDescribeCommand(o))
+	cmd.AddCommand(newGraphCommand(o))
+	cmd.AddCommand(newLintCommand())
+	cmd.AddCommand(newSimulateCommand())
+
+	return cmd
+}
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/manifest"
+	"sigs.k8s.io/service-apis/pkg/simulate"
+	"sigs.k8s.io/service-apis/pkg/topology"
+)
+
+func newSimulateCommand() *cobra.Command {
+	var req simulate.Request
+	var headers []string
+	var gateway, protocol string
+
+	cmd := &cobra.Command{
+		Use:   "simulate PATH...",
+		Short: "Show which route and backends would serve a request",
+		Long: "Show which listener, route, rule and backends would serve a request,\n" +
+			"and why every other candidate was not chosen.\n\n" +
+			"Each PATH is a YAML file or a directory of YAML files. The command\n" +
+			"fails if no route serves the request.",
+		Example: "  gwctl simulate ./manifests --port 80 --host www.example.com --path '/api?version=2' -H 'version: 2'",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req.Protocol = v1alpha1.ProtocolType(strings.ToUpper(protocol))
+			req.Headers = http.Header{}
+			for _, h := range headers {
+				i := strings.Index(h, ":")
+				if i < 0 {
+					return fmt.Errorf("invalid header %q, expected NAME: VALUE", h)
+				}
+				req.Headers.Add(strings.TrimSpace(h[:i]), strings.TrimSpace(h[i+1:]))
+			}
+
+			objects, err := manifest.Load(args...)
+			if err != nil {
+				return err
+			}
+			res := &topology.Resources{}
+			for _, o := range objects {
+				res.Add(o.Object)
+			}
+
+			var gw *v1alpha1.Gateway
+			if gateway != "" {
+				namespace, name := "default", gateway
+				if i := strings.Index(gateway, "/"); i >= 0 {
+					namespace, name = gateway[:i], gateway[i+1:]
+				}
+				if gw = res.Gateway(namespace, name); gw == nil {
+					return fmt.Errorf("gateway %s/%s not found", namespace, name)
+				}
+			}
+
+			result, err := simulate.Simulate(res, gw, req)
+			if err != nil {
+				return err
+			}
+
+			printSimulation(cmd.OutOrStdout(), result)
+			if result.Route == nil {
+				return errors.New("no route serves the request")
+			}
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&protocol, "protocol", "TCP", "Transport protocol of the request, TCP or UDP.")
+	flags.Int32Var(&req.Port, "port", 80, "Destination port of the request.")
+	flags.IPVar(&req.Source, "source", nil, "Client source address of the request.")
+	flags.StringVar(&req.Host, "host", "", "HTTP host of the request, optionally with a port.")
+	flags.StringVar(&req.SNI, "sni", "", "TLS server name of the request. Defaults to --host.")
+	flags.StringSliceVar(&req.ALPN, "alpn", nil, "ALPN protocols offered in the TLS handshake, in order of preference, e.g. h2,http/1.1.")
+	flags.StringVar(&req.Method, "method", "GET", "HTTP method of the request.")
+	flags.StringVar(&req.Path, "path", "/", "HTTP path of the request, optionally with a query string.")
+	flags.StringArrayVarP(&headers, "header", "H", nil, "HTTP header of the request, as NAME: VALUE. May be repeated.")
+	flags.StringVar(&gateway, "gateway", "", "Only consider this Gateway, as [NAMESPACE/]NAME.")
+
+	return cmd
+}
+
+func printSimulation(w io.Writer, result *simulate.Result) {
+	if result.Gateway == nil {
+		fmt.Fprintf(w, "Listener:  <none>\n")
+	} else {
+		fmt.Fprintf(w, "Listener:  Gateway %s/%s [%d] %s\n", result.Gateway.Namespace, result.Gateway.Name,
+			result.ListenerIndex, describeListener(result.Listener))
+	}
+
+	if result.Route == nil {
+		fmt.Fprintf(w, "Route:     <none>\n")
+	} else {
+		fmt.Fprintf(w, "Route:     %s %s/%s\n", result.Route.Kind, result.Route.Namespace, result.Route.Name)
+		fmt.Fprintf(w, "Rule:      %d\n", result.RuleIndex)
+		fmt.Fprintf(w, "Match:     %s\n", result.Match)
+		if hr, ok := result.Route.Object.(*v1alpha1.HTTPRoute); ok {
+			rule := hr.Spec.Rules[result.RuleIndex]
+			fmt.Fprintf(w, "Timeouts:  %s\n", describeTimeouts(rule.Timeouts))
+			fmt.Fprintf(w, "Retry:     %s\n", describeRetry(rule.Retry))
+		}
+		if result.Redirect != nil {
+			fmt.Fprintf(w, "Redirect:  %d %s\n", result.Redirect.StatusCode, result.Redirect.Location)
+		} else {
+			fmt.Fprintf(w, "Backends:\n")
+			if len(result.Backends) == 0 {
+				fmt.Fprintf(w, "  <no backends>\n")
+			}
+			for _, b := range result.Backends {
+				state := "resolved"
+				if !b.Resolved {
+					state = "unresolved: " + b.Message
+				}
+				fmt.Fprintf(w, "  -> %s weight=%d %.1f%% (%s)\n", b.Backend, weight(b.Weight), b.Share*100, state)
+			}
+		}
+	}
+
+	fmt.Fprintf(w, "Not Chosen:\n")
+	if len(result.Rejected) == 0 {
+		fmt.Fprintf(w, "  <none>\n")
+	}
+	for _, c := range result.Rejected {
+		fmt.Fprintf(w, "  %s: %s\n", c.Name, c.Reason)
+	}
+}
+
+func describeTimeouts(t *v1alpha1.HTTPRouteTimeouts) string {
+	var parts []string
+	if t != nil && t.Request != nil {
+		parts = append(parts, "request="+t.Request.Duration.String())
+	}
+	if t != nil && t.BackendRequest != nil {
+		parts = append(parts, "backendRequest="+t.BackendRequest.Duration.String())
+	}
+	if len(parts) == 0 {
+		return "<implementation default>"
+	}
+	return strings.Join(parts, " ")
+}
+
+func describeRetry(r *v1alpha1.HTTPRetryPolicy) string {
+	if r == nil {
+		return "<none>"
+	}
+	parts := []string{fmt.Sprintf("attempts=%d", r.Attempts)}
+	if r.PerTryTimeout != nil {
+		parts = append(parts, "perTryTimeout="+r.PerTryTimeout.Duration.String())
+	}
+	var on []string
+	for _, code := range r.RetryOn.StatusCodes {
+		on = append(on, fmt.Sprint(code))
+	}
+	if r.RetryOn.ConnectFailure {
+		on = append(on, "connect-failure")
+	}
+	parts = append(parts, "on="+strings.Join(on, ","))
+	if b := r.Backoff; b != nil {
+		backoff := "backoff=" + b.BaseInterval.Duration.String()
+		if b.MaxInterval != nil {
+			backoff += ".." + b.MaxInterval.Duration.String()
+		}
+		parts = append(parts, backoff)
+	}
+	return strings.Join(parts, " ")
+}
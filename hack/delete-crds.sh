@@ -21,9 +21,12 @@ set -o pipefail
 # Delete all CR and CRDs installed by service-apis.
 
 RESOURCES="
+  backendpolicies.networking.x-k8s.io
   gatewayclasses.networking.x-k8s.io
   gateways.networking.x-k8s.io
   httproutes.networking.x-k8s.io
+  grpcroutes.networking.x-k8s.io
+  referencegrants.networking.x-k8s.io
   tcproutes.networking.x-k8s.io"
 
 for TYPE in ${RESOURCES}; do
@@ -33,7 +33,9 @@ mkdir -p "$GOPATH/src/sigs.k8s.io"
 ln -s "${SCRIPT_ROOT}" "$GOPATH/src/sigs.k8s.io/service-apis"
 
 readonly OUTPUT_PKG=sigs.k8s.io/service-apis/pkg/client
+readonly APPLYCONFIG_PKG=${OUTPUT_PKG}/applyconfiguration
 readonly FQ_APIS=sigs.k8s.io/service-apis/apis/v1alpha1
+readonly FQ_HUB_APIS=sigs.k8s.io/service-apis/apis/v1alpha2
 readonly APIS_PKG=sigs.k8s.io/service-apis
 readonly CLIENTSET_NAME=versioned
 readonly CLIENTSET_PKG_NAME=clientset
@@ -47,35 +49,50 @@ readonly COMMON_FLAGS="${VERIFY_FLAG:-} --go-header-file ${SCRIPT_ROOT}/hack/boi
 
 echo "Generating deepcopy funcs"
 go run k8s.io/code-generator/cmd/deepcopy-gen \
-        --input-dirs "${FQ_APIS}" \
+        --input-dirs "${FQ_APIS},${FQ_HUB_APIS}" \
         -O zz_generated.deepcopy \
         --bounding-dirs "${APIS_PKG}" \
         ${COMMON_FLAGS}
 
+echo "Generating apply configurations at ${APPLYCONFIG_PKG}"
+go run k8s.io/code-generator/cmd/applyconfiguration-gen \
+        --input-dirs "${FQ_APIS},${FQ_HUB_APIS}" \
+        --output-package "${APPLYCONFIG_PKG}" \
+        ${COMMON_FLAGS}
+
 echo "Generating clientset at ${OUTPUT_PKG}/${CLIENTSET_PKG_NAME}"
 go run k8s.io/code-generator/cmd/client-gen \
         --clientset-name "${CLIENTSET_NAME}" \
         --input-base "" \
-        --input "${FQ_APIS}" \
+        --input "${FQ_APIS},${FQ_HUB_APIS}" \
+        --apply-configuration-package "${APPLYCONFIG_PKG}" \
         --output-package "${OUTPUT_PKG}/${CLIENTSET_PKG_NAME}" \
         ${COMMON_FLAGS}
 
 echo "Generating listers at ${OUTPUT_PKG}/listers"
 go run k8s.io/code-generator/cmd/lister-gen \
-        --input-dirs "${FQ_APIS}" \
+        --input-dirs "${FQ_APIS},${FQ_HUB_APIS}" \
         --output-package "${OUTPUT_PKG}/listers" \
         ${COMMON_FLAGS}
 
 echo "Generating informers at ${OUTPUT_PKG}/informers"
 go run k8s.io/code-generator/cmd/informer-gen \
-         --input-dirs "${FQ_APIS}" \
+         --input-dirs "${FQ_APIS},${FQ_HUB_APIS}" \
          --versioned-clientset-package "${OUTPUT_PKG}/${CLIENTSET_PKG_NAME}/${CLIENTSET_NAME}" \
          --listers-package "${OUTPUT_PKG}/listers" \
          --output-package "${OUTPUT_PKG}/informers" \
          ${COMMON_FLAGS}
 
-echo "Generating register at ${FQ_APIS}"
-go run k8s.io/code-generator/cmd/register-gen \
+for apis in "${FQ_APIS}" "${FQ_HUB_APIS}"; do
+  echo "Generating register at ${apis}"
+  go run k8s.io/code-generator/cmd/register-gen \
+          --input-dirs "${apis}" \
+          --output-package "${apis}" \
+          ${COMMON_FLAGS}
+done
+
+echo "Generating conversion funcs between ${FQ_APIS} and ${FQ_HUB_APIS}"
+go run k8s.io/code-generator/cmd/conversion-gen \
         --input-dirs "${FQ_APIS}" \
-        --output-package "${FQ_APIS}" \
+        -O zz_generated.conversion \
         ${COMMON_FLAGS}
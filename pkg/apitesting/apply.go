@@ -0,0 +1,232 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apitesting
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/json"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v4/merge"
+	smdschema "sigs.k8s.io/structured-merge-diff/v4/schema"
+	"sigs.k8s.io/structured-merge-diff/v4/typed"
+)
+
+// untypedYAML declares the types that fields without a structural schema
+// resolve to, as in the schemas generated by applyconfiguration-gen.
+const untypedYAML = `types:
+- name: __untyped_atomic_
+  scalar: untyped
+  list:
+    elementType:
+      namedType: __untyped_atomic_
+    elementRelationship: atomic
+  map:
+    elementType:
+      namedType: __untyped_atomic_
+    elementRelationship: atomic
+- name: __untyped_deduced_
+  scalar: untyped
+  list:
+    elementType:
+      namedType: __untyped_atomic_
+    elementRelationship: atomic
+  map:
+    elementType:
+      namedType: __untyped_deduced_
+    elementRelationship: separable
+`
+
+// deduced is the type of fields whose schema does not say how they merge.
+var deduced = func() smdschema.TypeRef {
+	name := "__untyped_deduced_"
+	return smdschema.TypeRef{NamedType: &name}
+}()
+
+// ApplyRequest is a server-side apply request, in which Manager applies
+// Object. Object may be a typed object or an apply configuration, and
+// must set its apiVersion and kind.
+type ApplyRequest struct {
+	Manager string
+	Object  interface{}
+}
+
+// ServerSideApply merges the requests, in order, into an object that is
+// initially empty, the way the API server merges server-side apply
+// requests into custom resources. Lists and maps merge as the
+// x-kubernetes-list-type and x-kubernetes-map-type markers of the CRD
+// schemas under crdDir say. A request that sets a field owned by another
+// manager fails t with the conflict. The merged object is decoded into
+// out.
+func ServerSideApply(t *testing.T, crdDir string, out runtime.Object, requests ...ApplyRequest) {
+	t.Helper()
+	schemas, err := loadSchemas(crdDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		parser   *typed.Parser
+		version  fieldpath.APIVersion
+		live     *typed.TypedValue
+		managers = fieldpath.ManagedFields{}
+		updater  = merge.Updater{Converter: sameVersion{}}
+	)
+	for _, r := range requests {
+		u, err := toUnstructured(r.Object)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gvk := schema.FromAPIVersionAndKind(fmt.Sprint(u["apiVersion"]), fmt.Sprint(u["kind"]))
+		if parser == nil {
+			crdSchema, ok := schemas[gvk]
+			if !ok {
+				t.Fatalf("no CRD in %s has %s", crdDir, gvk)
+			}
+			if parser, err = typed.NewParser(untypedYAML); err != nil {
+				t.Fatal(err)
+			}
+			parser.Schema.Types = append(parser.Schema.Types, smdschema.TypeDef{
+				Name: gvk.Kind,
+				Atom: mergeType(crdSchema).Inlined,
+			})
+			version = fieldpath.APIVersion(gvk.GroupVersion().String())
+			if live, err = parser.Type(gvk.Kind).FromUnstructured(map[string]interface{}{}); err != nil {
+				t.Fatal(err)
+			}
+		} else if fieldpath.APIVersion(gvk.GroupVersion().String()) != version {
+			t.Fatalf("%s applies %s, want %s", r.Manager, gvk.GroupVersion(), version)
+		}
+
+		config, err := parser.Type(gvk.Kind).FromUnstructured(u)
+		if err != nil {
+			t.Fatalf("%s applies an invalid %s: %v", r.Manager, gvk.Kind, err)
+		}
+		merged, newManagers, err := updater.Apply(live, config, version, managers, r.Manager, false)
+		if err != nil {
+			t.Fatalf("%s applying %s: %v", r.Manager, gvk.Kind, err)
+		}
+		// Apply returns no object when it leaves the live one unchanged.
+		if merged != nil {
+			live = merged
+		}
+		managers = newManagers
+	}
+	if live == nil {
+		t.Fatal("no apply requests")
+	}
+
+	merged, ok := live.AsValue().Unstructured().(map[string]interface{})
+	if !ok {
+		t.Fatalf("merged object is %T, want an object", live.AsValue().Unstructured())
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(merged, out); err != nil {
+		t.Fatalf("decoding merged object: %v", err)
+	}
+}
+
+// toUnstructured encodes obj as JSON and decodes it again, so that apply
+// configurations, which are not runtime.Objects, leave out the fields
+// they do not set.
+func toUnstructured(obj interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	u := map[string]interface{}{}
+	if err := json.Unmarshal(data, &u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// mergeType converts an OpenAPI schema node of a CRD into the
+// structured-merge-diff type that the API server merges it with.
+func mergeType(node map[string]interface{}) smdschema.TypeRef {
+	if node["x-kubernetes-preserve-unknown-fields"] == true || node["x-kubernetes-int-or-string"] == true {
+		return deduced
+	}
+
+	switch node["type"] {
+	case "object":
+		properties, _ := node["properties"].(map[string]interface{})
+		additional, _ := node["additionalProperties"].(map[string]interface{})
+		if properties == nil && additional == nil {
+			// metadata, whose schema the API server fills in.
+			return deduced
+		}
+		m := &smdschema.Map{ElementRelationship: smdschema.Separable}
+		if node["x-kubernetes-map-type"] == "atomic" {
+			m.ElementRelationship = smdschema.Atomic
+		}
+		names := make([]string, 0, len(properties))
+		for name := range properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			field, _ := properties[name].(map[string]interface{})
+			m.Fields = append(m.Fields, smdschema.StructField{Name: name, Type: mergeType(field)})
+		}
+		if additional != nil {
+			m.ElementType = mergeType(additional)
+		}
+		return smdschema.TypeRef{Inlined: smdschema.Atom{Map: m}}
+
+	case "array":
+		items, _ := node["items"].(map[string]interface{})
+		l := &smdschema.List{ElementType: mergeType(items), ElementRelationship: smdschema.Atomic}
+		switch node["x-kubernetes-list-type"] {
+		case "map":
+			l.ElementRelationship = smdschema.Associative
+			keys, _ := node["x-kubernetes-list-map-keys"].([]interface{})
+			for _, key := range keys {
+				l.Keys = append(l.Keys, fmt.Sprint(key))
+			}
+		case "set":
+			l.ElementRelationship = smdschema.Associative
+		}
+		return smdschema.TypeRef{Inlined: smdschema.Atom{List: l}}
+
+	case "string":
+		s := smdschema.String
+		return smdschema.TypeRef{Inlined: smdschema.Atom{Scalar: &s}}
+	case "integer", "number":
+		s := smdschema.Numeric
+		return smdschema.TypeRef{Inlined: smdschema.Atom{Scalar: &s}}
+	case "boolean":
+		s := smdschema.Boolean
+		return smdschema.TypeRef{Inlined: smdschema.Atom{Scalar: &s}}
+	}
+	return deduced
+}
+
+// sameVersion is the converter of an Updater whose requests all apply
+// the same version.
+type sameVersion struct{}
+
+func (sameVersion) Convert(object *typed.TypedValue, version fieldpath.APIVersion) (*typed.TypedValue, error) {
+	return object, nil
+}
+
+func (sameVersion) IsMissingVersionError(error) bool {
+	return false
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apitesting
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/json"
+	"sigs.k8s.io/yaml"
+)
+
+// Defaults checks that objects of every kind keep their shape once the
+// defaults in the CRD schemas under crdDir are applied: a defaulted
+// object must decode into its Go type and encode back unchanged, and
+// defaulting it again must be a no-op. A default that the Go type cannot
+// hold, or that an omitempty field drops, fails the check.
+func Defaults(t *testing.T, install InstallFunc, crdDir string) {
+	s := newScheme(t, install)
+	schemas, err := loadSchemas(crdDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(rand.Int63()), serializer.NewCodecFactory(s))
+
+	for _, gvk := range kinds(s, false) {
+		gvk := gvk
+		t.Run(gvk.Kind, func(t *testing.T) {
+			crdSchema, ok := schemas[gvk]
+			if !ok {
+				t.Fatalf("no CRD in %s serves %s", crdDir, gvk)
+			}
+			for i := 0; i < fuzzIters && !t.Failed(); i++ {
+				obj, err := s.New(gvk)
+				if err != nil {
+					t.Fatal(err)
+				}
+				f.Fuzz(obj)
+				obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+				u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+				if err != nil {
+					t.Fatal(err)
+				}
+				applyDefaults(u, crdSchema)
+
+				typed, err := s.New(gvk)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, typed); err != nil {
+					t.Fatalf("decoding defaulted object: %v", err)
+				}
+				encoded, err := runtime.DefaultUnstructuredConverter.ToUnstructured(typed)
+				if err != nil {
+					t.Fatal(err)
+				}
+				// The API server treats a null field as a missing one, so
+				// fields without omitempty may come back as null.
+				dropNulls(u)
+				dropNulls(encoded)
+				if !equality.Semantic.DeepEqual(u, encoded) {
+					t.Fatalf("defaulted object changed when decoded into %T:\ndefaulted: %v\nencoded:   %v", typed, u, encoded)
+				}
+
+				again := runtime.DeepCopyJSON(encoded)
+				applyDefaults(again, crdSchema)
+				if !equality.Semantic.DeepEqual(encoded, again) {
+					t.Fatalf("defaulting is not idempotent:\nonce:  %v\ntwice: %v", encoded, again)
+				}
+			}
+		})
+	}
+}
+
+// CheckDefaulted fails t unless obj already holds every default that
+// the CRD schemas under crdDir declare for the fields it sets, so that
+// the API server would return it unchanged.
+func CheckDefaulted(t *testing.T, install InstallFunc, crdDir string, obj runtime.Object) {
+	t.Helper()
+	s := newScheme(t, install)
+	schemas, err := loadSchemas(crdDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gvks, _, err := s.ObjectKinds(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crdSchema, ok := schemas[gvks[0]]
+	if !ok {
+		t.Fatalf("no CRD in %s serves %s", crdDir, gvks[0])
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dropNulls(u)
+	defaulted := runtime.DeepCopyJSON(u)
+	applyDefaults(defaulted, crdSchema)
+	if !equality.Semantic.DeepEqual(u, defaulted) {
+		t.Errorf("%s %s lacks CRD defaults:\ngot:       %v\ndefaulted: %v", gvks[0].Kind, obj.(metav1.Object).GetName(), u, defaulted)
+	}
+}
+
+// loadSchemas reads the CRDs in dir and returns the OpenAPI schema of
+// each kind and version they serve, as decoded JSON.
+func loadSchemas(dir string) (map[schema.GroupVersionKind]map[string]interface{}, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := map[schema.GroupVersionKind]map[string]interface{}{}
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if data, err = yaml.YAMLToJSON(data); err != nil {
+			return nil, err
+		}
+		var crd struct {
+			Spec struct {
+				Group string `json:"group"`
+				Names struct {
+					Kind string `json:"kind"`
+				} `json:"names"`
+				Versions []struct {
+					Name   string `json:"name"`
+					Schema struct {
+						OpenAPIV3Schema runtime.RawExtension `json:"openAPIV3Schema"`
+					} `json:"schema"`
+				} `json:"versions"`
+			} `json:"spec"`
+		}
+		if err := json.Unmarshal(data, &crd); err != nil {
+			return nil, err
+		}
+		for _, v := range crd.Spec.Versions {
+			gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: v.Name, Kind: crd.Spec.Names.Kind}
+			// Decoding the schema on its own keeps integer defaults
+			// as int64, like the fields of a converted object.
+			var openAPI map[string]interface{}
+			if err := json.Unmarshal(v.Schema.OpenAPIV3Schema.Raw, &openAPI); err != nil {
+				return nil, err
+			}
+			schemas[gvk] = openAPI
+		}
+	}
+	return schemas, nil
+}
+
+// applyDefaults sets the defaults of node on the unset fields of obj,
+// the way the API server defaults custom resources: a default applies
+// where its field is missing or null, and defaulting recurses into
+// fields, array items and map values.
+func applyDefaults(obj interface{}, node map[string]interface{}) {
+	switch obj := obj.(type) {
+	case map[string]interface{}:
+		if props, ok := node["properties"].(map[string]interface{}); ok {
+			for name, p := range props {
+				prop, _ := p.(map[string]interface{})
+				if def, ok := prop["default"]; ok && obj[name] == nil {
+					obj[name] = runtime.DeepCopyJSONValue(def)
+				}
+				if v, ok := obj[name]; ok {
+					applyDefaults(v, prop)
+				}
+			}
+		}
+		if additional, ok := node["additionalProperties"].(map[string]interface{}); ok {
+			for _, v := range obj {
+				applyDefaults(v, additional)
+			}
+		}
+	case []interface{}:
+		if items, ok := node["items"].(map[string]interface{}); ok {
+			for _, v := range obj {
+				applyDefaults(v, items)
+			}
+		}
+	}
+}
+
+// dropNulls removes the null fields of obj.
+func dropNulls(obj interface{}) {
+	switch obj := obj.(type) {
+	case map[string]interface{}:
+		for k, v := range obj {
+			if v == nil {
+				delete(obj, k)
+			} else {
+				dropNulls(v)
+			}
+		}
+	case []interface{}:
+		for _, v := range obj {
+			dropNulls(v)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apitesting
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/roundtrip"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+)
+
+// updateEnv names the environment variable that makes Fixtures rewrite
+// the HEAD fixtures instead of comparing against them.
+const updateEnv = "UPDATE_COMPATIBILITY_FIXTURE_DATA"
+
+// modulePrefix is the import path prefix of the packages whose structs
+// are filled in by sparse.
+const modulePrefix = "sigs.k8s.io/service-apis/"
+
+// fillFuncs fill the types that the deterministic filler of the full
+// fixtures cannot fill field by field. They follow the defaults of
+// apimachinery's own compatibility tests, which are replaced as soon as
+// any fill function is passed.
+var fillFuncs = map[reflect.Type]roundtrip.FillFunc{
+	reflect.TypeOf(&metav1.TypeMeta{}): func(s string, i int, obj interface{}) {
+		// The kind and apiVersion are set once the object is filled.
+		*obj.(*metav1.TypeMeta) = metav1.TypeMeta{}
+	},
+	reflect.TypeOf(&metav1.FieldsV1{}): func(s string, i int, obj interface{}) {
+		obj.(*metav1.FieldsV1).Raw = []byte(`{}`)
+	},
+	reflect.TypeOf(&metav1.Time{}): func(s string, i int, obj interface{}) {
+		// Use the integer as an offset from the year.
+		obj.(*metav1.Time).Time = time.Date(2000+i, 1, 1, 1, 1, 1, 0, time.UTC)
+	},
+}
+
+// Fixtures checks that the wire format of every kind matches the golden
+// files in dir.
+//
+// dir/HEAD holds the current format of each kind, in JSON and YAML. The
+// full fixtures set every field. The sparse fixtures set only enough of
+// each object to reach every nested struct, so they record how unset
+// fields are written: a field that loses or gains omitempty changes them.
+// When a format change is intended, run the tests with
+// UPDATE_COMPATIBILITY_FIXTURE_DATA=true and commit the new files.
+//
+// Each other directory in dir, named after a release such as v0.1.0,
+// holds the fixtures of that release. Those must still decode, and must
+// encode back to the same bytes, or to the contents of the matching
+// .after_roundtrip file when a field was deliberately changed.
+func Fixtures(t *testing.T, install InstallFunc, dir string) {
+	s := newScheme(t, install)
+	jsonSerializer := json.NewSerializerWithOptions(json.DefaultMetaFactory, s, s, json.SerializerOptions{Pretty: true})
+	yamlSerializer := json.NewYAMLSerializer(json.DefaultMetaFactory, s, s)
+	serializers := map[string]runtime.Serializer{"json": jsonSerializer, "yaml": yamlSerializer}
+
+	for _, gvk := range kinds(s, false) {
+		gvk := gvk
+		t.Run(gvk.Kind, func(t *testing.T) {
+			full, err := roundtrip.CompatibilityTestObject(s, gvk, fillFuncs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sparse, err := s.New(gvk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fill(reflect.ValueOf(sparse).Elem())
+			sparse.GetObjectKind().SetGroupVersionKind(gvk)
+
+			for _, ext := range []string{"json", "yaml"} {
+				checkFixture(t, serializers[ext], gvk, full, filepath.Join(dir, "HEAD", fixtureName(gvk)+"."+ext))
+				checkFixture(t, serializers[ext], gvk, sparse, filepath.Join(dir, "HEAD", fixtureName(gvk)+".sparse."+ext))
+			}
+		})
+	}
+
+	releases, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	for _, release := range releases {
+		if !release.IsDir() || release.Name() == "HEAD" {
+			continue
+		}
+		release := release.Name()
+		t.Run(release, func(t *testing.T) {
+			checkRelease(t, s, serializers, filepath.Join(dir, release))
+		})
+	}
+}
+
+// fixtureName returns the file name, without extension, of the fixtures
+// for gvk.
+func fixtureName(gvk schema.GroupVersionKind) string {
+	return gvk.Group + "." + gvk.Version + "." + gvk.Kind
+}
+
+// checkFixture compares the encoding of obj with the file at path, and
+// checks that the file decodes to obj.
+func checkFixture(t *testing.T, serializer runtime.Serializer, gvk schema.GroupVersionKind, obj runtime.Object, path string) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := serializer.Encode(obj, &buf); err != nil {
+		t.Fatalf("encoding %s: %v", filepath.Base(path), err)
+	}
+
+	if os.Getenv(updateEnv) == "true" {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v; run the tests with %s=true to create it", err, updateEnv)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("%s does not match the current encoding; if the change is intended, run the tests with %s=true\ngot:\n%s\nwant:\n%s",
+			path, updateEnv, buf.String(), want)
+	}
+
+	decoded, _, err := serializer.Decode(want, &gvk, nil)
+	if err != nil {
+		t.Fatalf("decoding %s: %v", path, err)
+	}
+	if !equality.Semantic.DeepEqual(obj, decoded) {
+		t.Errorf("%s does not decode to the object it was written from", path)
+	}
+}
+
+// checkRelease checks that the fixtures of a past release still decode,
+// and that they encode back to the same bytes.
+func checkRelease(t *testing.T, s *runtime.Scheme, serializers map[string]runtime.Serializer, dir string) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, f := range files {
+		if !strings.Contains(f.Name(), ".after_roundtrip.") {
+			names = append(names, f.Name())
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		ext := strings.TrimPrefix(filepath.Ext(name), ".")
+		serializer, ok := serializers[ext]
+		if !ok {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		obj, gvk, err := serializer.Decode(data, nil, nil)
+		if err != nil {
+			t.Errorf("decoding %s: %v", name, err)
+			continue
+		}
+		if !s.Recognizes(*gvk) {
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := serializer.Encode(obj, &buf); err != nil {
+			t.Errorf("encoding %s: %v", name, err)
+			continue
+		}
+		want := data
+		after := strings.TrimSuffix(name, "."+ext) + ".after_roundtrip." + ext
+		if data, err := ioutil.ReadFile(filepath.Join(dir, after)); err == nil {
+			want = data
+		}
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Errorf("%s does not survive a round trip; if the change is intended, record the new encoding in %s\ngot:\n%s\nwant:\n%s",
+				name, after, buf.String(), want)
+		}
+	}
+}
+
+// fill sets the smallest value of v that reaches every struct type
+// declared in this module: each slice gets one zero element and each
+// pointer to such a struct a zero struct, which are filled in turn.
+// Other pointers, maps and types from other modules are left zero, so the
+// encoding shows how every unset field is written.
+func fill(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		elem := v.Type().Elem()
+		if elem.Kind() != reflect.Struct || !strings.HasPrefix(elem.PkgPath(), modulePrefix) {
+			return
+		}
+		v.Set(reflect.New(elem))
+		fill(v.Elem())
+	case reflect.Struct:
+		if !strings.HasPrefix(v.Type().PkgPath(), modulePrefix) {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				fill(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fill(v.Index(0))
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apitesting holds the serialization tests shared by the versions
+// of the service-apis API group: fuzzed round trips, golden wire-format
+// fixtures and the stability of CRD defaulting.
+package apitesting
+
+import (
+	"bytes"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/apitesting/roundtrip"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+)
+
+// fuzzIters is the number of fuzzed objects serialized for each kind.
+const fuzzIters = 20
+
+// InstallFunc adds the types of an API version to a scheme.
+type InstallFunc func(*runtime.Scheme) error
+
+// newScheme returns a scheme with the types added by install.
+func newScheme(t *testing.T, install InstallFunc) *runtime.Scheme {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	if err := install(s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// kinds returns the kinds defined by the API version, in name order.
+// Kinds that AddToGroupVersion registers in every version, such as
+// ListOptions, are omitted.
+func kinds(s *runtime.Scheme, withLists bool) []schema.GroupVersionKind {
+	metaPkg := reflect.TypeOf(metav1.ObjectMeta{}).PkgPath()
+
+	var gvks []schema.GroupVersionKind
+	for gvk, typ := range s.AllKnownTypes() {
+		if typ.PkgPath() == metaPkg {
+			continue
+		}
+		if !withLists && len(gvk.Kind) > 4 && gvk.Kind[len(gvk.Kind)-4:] == "List" {
+			continue
+		}
+		gvks = append(gvks, gvk)
+	}
+	sort.Slice(gvks, func(i, j int) bool { return gvks[i].String() < gvks[j].String() })
+	return gvks
+}
+
+// RoundTrip serializes fuzzed objects of every kind, including list
+// kinds, to JSON and YAML and back, and checks that nothing is lost. For
+// JSON it also checks that DeepCopy shares no memory with the original,
+// and that encoding the same object twice gives the same bytes.
+func RoundTrip(t *testing.T, install InstallFunc) {
+	s := newScheme(t, install)
+	codecs := serializer.NewCodecFactory(s)
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(rand.Int63()), codecs)
+	yamlSerializer := json.NewYAMLSerializer(json.DefaultMetaFactory, s, s)
+
+	for _, gvk := range kinds(s, true) {
+		gvk := gvk
+		t.Run(gvk.Kind, func(t *testing.T) {
+			roundtrip.RoundTripSpecificKindWithoutProtobuf(t, gvk, s, codecs, f, nil)
+
+			for i := 0; i < fuzzIters && !t.Failed(); i++ {
+				obj, err := s.New(gvk)
+				if err != nil {
+					t.Fatal(err)
+				}
+				f.Fuzz(obj)
+				obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+				var buf bytes.Buffer
+				if err := yamlSerializer.Encode(obj, &buf); err != nil {
+					t.Fatalf("encoding YAML: %v", err)
+				}
+				decoded, _, err := yamlSerializer.Decode(buf.Bytes(), &gvk, nil)
+				if err != nil {
+					t.Fatalf("decoding YAML: %v\n%s", err, buf.String())
+				}
+				if !equality.Semantic.DeepEqual(obj, decoded) {
+					t.Errorf("YAML round trip changed the object:\n%s", buf.String())
+				}
+			}
+		})
+	}
+}
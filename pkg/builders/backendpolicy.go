@@ -0,0 +1,292 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builders
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// BackendPolicyBuilder builds a BackendPolicy.
+type BackendPolicyBuilder struct {
+	p v1alpha1.BackendPolicy
+}
+
+// NewBackendPolicy starts a BackendPolicy without backends.
+func NewBackendPolicy(namespace, name string) *BackendPolicyBuilder {
+	return &BackendPolicyBuilder{p: v1alpha1.BackendPolicy{
+		ObjectMeta: objectMeta(namespace, name),
+		Spec:       v1alpha1.BackendPolicySpec{BackendRefs: []v1alpha1.BackendRef{}},
+	}}
+}
+
+// Labels adds labels to the policy.
+func (b *BackendPolicyBuilder) Labels(labels map[string]string) *BackendPolicyBuilder {
+	addLabels(&b.p.ObjectMeta, labels)
+	return b
+}
+
+// Annotations adds annotations to the policy.
+func (b *BackendPolicyBuilder) Annotations(annotations map[string]string) *BackendPolicyBuilder {
+	addAnnotations(&b.p.ObjectMeta, annotations)
+	return b
+}
+
+// Service applies the policy to the named Service. A zero port applies it
+// to all ports.
+func (b *BackendPolicyBuilder) Service(name string, port int32) *BackendPolicyBuilder {
+	ref := v1alpha1.BackendRef{Group: "core", Kind: "Service", Name: name}
+	if port != 0 {
+		ref.Port = &port
+	}
+	b.p.Spec.BackendRefs = append(b.p.Spec.BackendRefs, ref)
+	return b
+}
+
+// Backend applies the policy to an object of the given group and kind.
+func (b *BackendPolicyBuilder) Backend(group, kind, name string) *BackendPolicyBuilder {
+	b.p.Spec.BackendRefs = append(b.p.Spec.BackendRefs, v1alpha1.BackendRef{Group: group, Kind: kind, Name: name})
+	return b
+}
+
+// TLS sets the TLS configuration of connections to the backends.
+func (b *BackendPolicyBuilder) TLS(tls *BackendTLSBuilder) *BackendPolicyBuilder {
+	t := tls.Build()
+	b.p.Spec.TLS = &t
+	return b
+}
+
+// LoadBalancer balances requests over the endpoints of the backends with
+// the given algorithm.
+func (b *BackendPolicyBuilder) LoadBalancer(lbType v1alpha1.LoadBalancerType) *BackendPolicyBuilder {
+	b.p.Spec.LoadBalancer = &v1alpha1.BackendLoadBalancer{Type: lbType}
+	return b
+}
+
+// RingHash balances requests by a consistent hash of the named header or
+// cookie.
+func (b *BackendPolicyBuilder) RingHash(hashOn v1alpha1.HashKeyType, name string) *BackendPolicyBuilder {
+	b.p.Spec.LoadBalancer = &v1alpha1.BackendLoadBalancer{
+		Type:   v1alpha1.LoadBalancerRingHash,
+		HashOn: &v1alpha1.LoadBalancerHashKey{Type: hashOn, Name: name},
+	}
+	return b
+}
+
+// HealthCheck sets the active health check of the backends.
+func (b *BackendPolicyBuilder) HealthCheck(check *HealthCheckBuilder) *BackendPolicyBuilder {
+	c := check.Build()
+	b.p.Spec.HealthCheck = &c
+	return b
+}
+
+// CookieAffinity pins clients to an endpoint with the named cookie. A
+// zero ttl makes it a session cookie.
+func (b *BackendPolicyBuilder) CookieAffinity(name string, ttl time.Duration) *BackendPolicyBuilder {
+	cookie := &v1alpha1.SessionAffinityCookieConfig{Name: name}
+	if ttl != 0 {
+		cookie.TTL = &metav1.Duration{Duration: ttl}
+	}
+	b.p.Spec.SessionAffinity = &v1alpha1.BackendSessionAffinity{Type: v1alpha1.SessionAffinityCookie, Cookie: cookie}
+	return b
+}
+
+func (b *BackendPolicyBuilder) connectionPool() *v1alpha1.BackendConnectionPool {
+	if b.p.Spec.ConnectionPool == nil {
+		b.p.Spec.ConnectionPool = &v1alpha1.BackendConnectionPool{}
+	}
+	return b.p.Spec.ConnectionPool
+}
+
+// MaxConnections limits the connections to each endpoint.
+func (b *BackendPolicyBuilder) MaxConnections(n int32) *BackendPolicyBuilder {
+	b.connectionPool().MaxConnections = &n
+	return b
+}
+
+// MaxPendingRequests limits the requests waiting for a connection.
+func (b *BackendPolicyBuilder) MaxPendingRequests(n int32) *BackendPolicyBuilder {
+	b.connectionPool().MaxPendingRequests = &n
+	return b
+}
+
+// MaxRequestsPerConnection limits the requests sent over one connection.
+func (b *BackendPolicyBuilder) MaxRequestsPerConnection(n int32) *BackendPolicyBuilder {
+	b.connectionPool().MaxRequestsPerConnection = &n
+	return b
+}
+
+// IdleTimeout closes connections that were idle for d.
+func (b *BackendPolicyBuilder) IdleTimeout(d time.Duration) *BackendPolicyBuilder {
+	b.connectionPool().IdleTimeout = &metav1.Duration{Duration: d}
+	return b
+}
+
+// OutlierDetection ejects endpoints for baseEjectionTime after 5
+// consecutive 5xx responses or connection failures, ejecting at most 10
+// percent of the endpoints at once.
+func (b *BackendPolicyBuilder) OutlierDetection(baseEjectionTime time.Duration) *BackendPolicyBuilder {
+	maxEjectionPercent := int32(10)
+	b.p.Spec.OutlierDetection = &v1alpha1.BackendOutlierDetection{
+		Consecutive5xxErrors: 5,
+		BaseEjectionTime:     metav1.Duration{Duration: baseEjectionTime},
+		MaxEjectionPercent:   &maxEjectionPercent,
+	}
+	return b
+}
+
+// Status replaces the status of the policy.
+func (b *BackendPolicyBuilder) Status(status *BackendPolicyStatusBuilder) *BackendPolicyBuilder {
+	b.p.Status = status.Build()
+	return b
+}
+
+// Build returns the policy.
+func (b *BackendPolicyBuilder) Build() *v1alpha1.BackendPolicy {
+	return b.p.DeepCopy()
+}
+
+// BackendTLSBuilder builds the TLS configuration of a BackendPolicy.
+type BackendTLSBuilder struct {
+	t v1alpha1.BackendTLSConfig
+}
+
+// BackendTLS starts a configuration that verifies the backends with the
+// CA certificates in the named Secret.
+func BackendTLS(caSecret string) *BackendTLSBuilder {
+	return &BackendTLSBuilder{t: v1alpha1.BackendTLSConfig{
+		CertificateAuthorityRef: &v1alpha1.LocalObjectReference{Group: "core", Kind: "Secret", Name: caSecret},
+	}}
+}
+
+// ClientCertificate presents the certificate in the named Secret to the
+// backends.
+func (b *BackendTLSBuilder) ClientCertificate(secret string) *BackendTLSBuilder {
+	b.t.ClientCertificateRef = &v1alpha1.LocalObjectReference{Group: "core", Kind: "Secret", Name: secret}
+	return b
+}
+
+// Versions limits the TLS versions used. An empty version leaves that
+// bound to the implementation.
+func (b *BackendTLSBuilder) Versions(min, max v1alpha1.TLSVersion) *BackendTLSBuilder {
+	b.t.MinVersion, b.t.MaxVersion = nil, nil
+	if min != "" {
+		b.t.MinVersion = &min
+	}
+	if max != "" {
+		b.t.MaxVersion = &max
+	}
+	return b
+}
+
+// Option sets an implementation-specific option.
+func (b *BackendTLSBuilder) Option(name, value string) *BackendTLSBuilder {
+	if b.t.Options == nil {
+		b.t.Options = map[string]string{}
+	}
+	b.t.Options[name] = value
+	return b
+}
+
+// Build returns the TLS configuration.
+func (b *BackendTLSBuilder) Build() v1alpha1.BackendTLSConfig {
+	return *b.t.DeepCopy()
+}
+
+// HealthCheckBuilder builds the active health check of a BackendPolicy.
+type HealthCheckBuilder struct {
+	h v1alpha1.BackendHealthCheck
+}
+
+// HTTPHealthCheck starts a check that requests path every interval. An
+// endpoint becomes healthy after 2 passed checks and unhealthy after 3
+// failed ones.
+func HTTPHealthCheck(path string, interval time.Duration) *HealthCheckBuilder {
+	b := TCPHealthCheck(interval)
+	b.h.Type = v1alpha1.HealthCheckHTTP
+	b.h.HTTP = &v1alpha1.HTTPHealthCheck{Path: path}
+	return b
+}
+
+// TCPHealthCheck starts a check that connects every interval. An
+// endpoint becomes healthy after 2 passed checks and unhealthy after 3
+// failed ones.
+func TCPHealthCheck(interval time.Duration) *HealthCheckBuilder {
+	return &HealthCheckBuilder{h: v1alpha1.BackendHealthCheck{
+		Type:               v1alpha1.HealthCheckTCP,
+		Interval:           metav1.Duration{Duration: interval},
+		HealthyThreshold:   2,
+		UnhealthyThreshold: 3,
+	}}
+}
+
+// Hostname sets the Host header of HTTP checks.
+func (b *HealthCheckBuilder) Hostname(hostname string) *HealthCheckBuilder {
+	if b.h.HTTP != nil {
+		b.h.HTTP.Hostname = &hostname
+	}
+	return b
+}
+
+// Timeout fails checks that take longer than d.
+func (b *HealthCheckBuilder) Timeout(d time.Duration) *HealthCheckBuilder {
+	b.h.Timeout = &metav1.Duration{Duration: d}
+	return b
+}
+
+// Thresholds sets how many checks must pass or fail in a row to change
+// the health of an endpoint.
+func (b *HealthCheckBuilder) Thresholds(healthy, unhealthy int32) *HealthCheckBuilder {
+	b.h.HealthyThreshold = healthy
+	b.h.UnhealthyThreshold = unhealthy
+	return b
+}
+
+// Build returns the health check.
+func (b *HealthCheckBuilder) Build() v1alpha1.BackendHealthCheck {
+	return *b.h.DeepCopy()
+}
+
+// BackendPolicyStatusBuilder builds the status of a BackendPolicy.
+type BackendPolicyStatusBuilder struct {
+	s v1alpha1.BackendPolicyStatus
+}
+
+// BackendPolicyStatus starts an empty BackendPolicy status.
+func BackendPolicyStatus() *BackendPolicyStatusBuilder {
+	return &BackendPolicyStatusBuilder{}
+}
+
+// Condition adds conditions of the policy.
+func (b *BackendPolicyStatusBuilder) Condition(conditions ...*ConditionBuilder) *BackendPolicyStatusBuilder {
+	b.s.Conditions = append(b.s.Conditions, buildConditions(conditions)...)
+	return b
+}
+
+// UnsupportedSettings records the settings the implementation ignores.
+func (b *BackendPolicyStatusBuilder) UnsupportedSettings(settings ...string) *BackendPolicyStatusBuilder {
+	b.s.UnsupportedSettings = append(b.s.UnsupportedSettings, settings...)
+	return b
+}
+
+// Build returns the policy status.
+func (b *BackendPolicyStatusBuilder) Build() v1alpha1.BackendPolicyStatus {
+	return *b.s.DeepCopy()
+}
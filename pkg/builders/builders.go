@@ -0,0 +1,240 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package builders constructs service-apis objects for tests. Every kind
+// has a New* constructor that returns a fluent builder, and Build returns
+// a copy of the object built so far, so a builder can be extended after
+// it was built.
+//
+// Builders fill in the defaults that the CRD schemas declare for the
+// fields they create, so that a built object compares equal to the object
+// the API server returns after it was created.
+package builders
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// defaultTransitionTime is the lastTransitionTime of the conditions that
+// the CRD schemas add to new objects.
+var defaultTransitionTime = metav1.NewTime(time.Unix(0, 0).UTC())
+
+// objectMeta returns the metadata of an object with the given namespace
+// and name. Cluster-scoped objects use an empty namespace.
+func objectMeta(namespace, name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Namespace: namespace, Name: name}
+}
+
+// addLabels merges labels into the labels of meta.
+func addLabels(meta *metav1.ObjectMeta, labels map[string]string) {
+	if len(labels) == 0 {
+		return
+	}
+	if meta.Labels == nil {
+		meta.Labels = map[string]string{}
+	}
+	for k, v := range labels {
+		meta.Labels[k] = v
+	}
+}
+
+// addAnnotations merges annotations into the annotations of meta.
+func addAnnotations(meta *metav1.ObjectMeta, annotations map[string]string) {
+	if len(annotations) == 0 {
+		return
+	}
+	if meta.Annotations == nil {
+		meta.Annotations = map[string]string{}
+	}
+	for k, v := range annotations {
+		meta.Annotations[k] = v
+	}
+}
+
+// ConditionBuilder builds a metav1.Condition.
+type ConditionBuilder struct {
+	c metav1.Condition
+}
+
+// Condition starts a condition of the given type, status and reason. The
+// condition types and reasons of this API are typed strings, so callers
+// convert them, as in Condition(string(v1alpha1.ConditionRouteAdmitted),
+// metav1.ConditionTrue, "Admitted").
+func Condition(conditionType string, status metav1.ConditionStatus, reason string) *ConditionBuilder {
+	return &ConditionBuilder{c: metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		LastTransitionTime: defaultTransitionTime,
+	}}
+}
+
+// Message sets the human readable message of the condition.
+func (b *ConditionBuilder) Message(message string) *ConditionBuilder {
+	b.c.Message = message
+	return b
+}
+
+// ObservedGeneration sets the generation the condition was set for.
+func (b *ConditionBuilder) ObservedGeneration(generation int64) *ConditionBuilder {
+	b.c.ObservedGeneration = generation
+	return b
+}
+
+// LastTransitionTime sets when the condition last changed. It defaults
+// to the Unix epoch, like the conditions defaulted by the CRD schemas.
+func (b *ConditionBuilder) LastTransitionTime(t time.Time) *ConditionBuilder {
+	b.c.LastTransitionTime = metav1.NewTime(t)
+	return b
+}
+
+// Build returns the condition.
+func (b *ConditionBuilder) Build() metav1.Condition {
+	return *b.c.DeepCopy()
+}
+
+func buildConditions(conditions []*ConditionBuilder) []metav1.Condition {
+	var out []metav1.Condition
+	for _, c := range conditions {
+		out = append(out, c.Build())
+	}
+	return out
+}
+
+// ForwardToBuilder builds the backend a route rule forwards to. The same
+// builder serves all route kinds; filters are only kept by HTTPRoutes.
+type ForwardToBuilder struct {
+	f v1alpha1.HTTPRouteForwardTo
+}
+
+// Service forwards to the named Service on port. The weight defaults to 1.
+func Service(name string, port int32) *ForwardToBuilder {
+	return &ForwardToBuilder{f: v1alpha1.HTTPRouteForwardTo{
+		ServiceName: &name,
+		Port:        &port,
+		Weight:      1,
+	}}
+}
+
+// Backend forwards to an object of the given group and kind, such as a
+// custom backend resource. The weight defaults to 1.
+func Backend(group, kind, name string) *ForwardToBuilder {
+	return &ForwardToBuilder{f: v1alpha1.HTTPRouteForwardTo{
+		BackendRef: &v1alpha1.LocalObjectReference{Group: group, Kind: kind, Name: name},
+		Weight:     1,
+	}}
+}
+
+// Namespace forwards to the backend in another namespace. The reference
+// must be allowed by a ReferenceGrant in that namespace.
+func (b *ForwardToBuilder) Namespace(namespace string) *ForwardToBuilder {
+	b.f.Namespace = &namespace
+	return b
+}
+
+// Port sets the port of the backend.
+func (b *ForwardToBuilder) Port(port int32) *ForwardToBuilder {
+	b.f.Port = &port
+	return b
+}
+
+// Weight sets the proportion of requests forwarded to the backend.
+func (b *ForwardToBuilder) Weight(weight int32) *ForwardToBuilder {
+	b.f.Weight = weight
+	return b
+}
+
+// Filter adds filters that apply to requests forwarded to the backend.
+// Only HTTPRoutes support them.
+func (b *ForwardToBuilder) Filter(filters ...HTTPFilter) *ForwardToBuilder {
+	for _, f := range filters {
+		b.f.Filters = append(b.f.Filters, f.Build())
+	}
+	return b
+}
+
+// Build returns the backend of an HTTPRoute rule.
+func (b *ForwardToBuilder) Build() v1alpha1.HTTPRouteForwardTo {
+	return *b.f.DeepCopy()
+}
+
+// BuildRoute returns the backend of a GRPCRoute, TCPRoute, TLSRoute or
+// UDPRoute rule. Filters are dropped.
+func (b *ForwardToBuilder) BuildRoute() v1alpha1.RouteForwardTo {
+	f := b.Build()
+	return v1alpha1.RouteForwardTo{
+		ServiceName: f.ServiceName,
+		BackendRef:  f.BackendRef,
+		Namespace:   f.Namespace,
+		Port:        f.Port,
+		Weight:      f.Weight,
+	}
+}
+
+func buildForwardTo(backends []*ForwardToBuilder) []v1alpha1.RouteForwardTo {
+	var out []v1alpha1.RouteForwardTo
+	for _, f := range backends {
+		out = append(out, f.BuildRoute())
+	}
+	return out
+}
+
+// defaultRouteGateways is the default gateways field of all route kinds.
+func defaultRouteGateways() v1alpha1.RouteGateways {
+	return v1alpha1.RouteGateways{Allow: v1alpha1.GatewayAllowSameNamespace}
+}
+
+// addGateway allows the Gateway namespace/name to use the route.
+func addGateway(gateways *v1alpha1.RouteGateways, namespace, name string) {
+	gateways.Allow = v1alpha1.GatewayAllowFromList
+	gateways.GatewayRefs = append(gateways.GatewayRefs, v1alpha1.GatewayReference{Namespace: namespace, Name: name})
+}
+
+// RouteStatusBuilder builds the status shared by all route kinds.
+type RouteStatusBuilder struct {
+	s v1alpha1.RouteStatus
+}
+
+// RouteStatus starts an empty route status.
+func RouteStatus() *RouteStatusBuilder {
+	return &RouteStatusBuilder{s: v1alpha1.RouteStatus{Gateways: []v1alpha1.RouteGatewayStatus{}}}
+}
+
+// Gateway adds the status of the route for the Gateway namespace/name.
+func (b *RouteStatusBuilder) Gateway(namespace, name string, conditions ...*ConditionBuilder) *RouteStatusBuilder {
+	b.s.Gateways = append(b.s.Gateways, v1alpha1.RouteGatewayStatus{
+		GatewayRef: v1alpha1.GatewayReference{Namespace: namespace, Name: name},
+		Conditions: buildConditions(conditions),
+	})
+	return b
+}
+
+// Build returns the route status.
+func (b *RouteStatusBuilder) Build() v1alpha1.RouteStatus {
+	return *b.s.DeepCopy()
+}
+
+// Secret returns a reference to the named Secret, for use as a
+// certificate reference.
+func Secret(name string) v1alpha1.CertificateObjectReference {
+	return v1alpha1.CertificateObjectReference{
+		LocalObjectReference: v1alpha1.LocalObjectReference{Group: "core", Kind: "Secret", Name: name},
+	}
+}
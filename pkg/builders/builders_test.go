@@ -0,0 +1,228 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builders
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/apitesting"
+)
+
+func TestHTTPRoute(t *testing.T) {
+	got := NewHTTPRoute("ns", "name").
+		Hostnames("example.com").
+		Rule(Match().PathPrefix("/a").Header("x", "y")).
+		ForwardTo(Service("svc", 8080).Weight(90), Service("canary", 8080).Weight(10)).
+		Rule().
+		Filter(Redirect().Scheme("https")).
+		Build()
+
+	svc, canary, port, code, https := "svc", "canary", int32(8080), int32(302), "https"
+	want := &v1alpha1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "name"},
+		Spec: v1alpha1.HTTPRouteSpec{
+			Gateways:  v1alpha1.RouteGateways{Allow: v1alpha1.GatewayAllowSameNamespace},
+			Hostnames: []v1alpha1.HTTPRouteHostname{"example.com"},
+			Rules: []v1alpha1.HTTPRouteRule{{
+				Matches: []v1alpha1.HTTPRouteMatch{{
+					Path: v1alpha1.HTTPPathMatch{Type: v1alpha1.PathMatchPrefix, Value: "/a"},
+					Headers: &v1alpha1.HTTPHeaderMatch{
+						Type:   v1alpha1.HeaderMatchExact,
+						Values: map[string]string{"x": "y"},
+					},
+				}},
+				ForwardTo: []v1alpha1.HTTPRouteForwardTo{
+					{ServiceName: &svc, Port: &port, Weight: 90},
+					{ServiceName: &canary, Port: &port, Weight: 10},
+				},
+			}, {
+				Matches: []v1alpha1.HTTPRouteMatch{{
+					Path: v1alpha1.HTTPPathMatch{Type: v1alpha1.PathMatchPrefix, Value: "/"},
+				}},
+				Filters: []v1alpha1.HTTPRouteFilter{{
+					Type:            v1alpha1.FilterTypeHTTPRequestRedirect,
+					RequestRedirect: &v1alpha1.HTTPRequestRedirectFilter{Scheme: &https, StatusCode: &code},
+				}},
+			}},
+		},
+	}
+	if !equality.Semantic.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildCopies(t *testing.T) {
+	b := NewGateway("ns", "gw", "acme").Listener(Listener(v1alpha1.HTTPProtocolType, 80))
+	first := b.Build()
+	first.Spec.Listeners[0].Port = 8080
+	b.Listener(Listener(v1alpha1.HTTPProtocolType, 81))
+
+	second := b.Build()
+	if len(first.Spec.Listeners) != 1 {
+		t.Errorf("extending the builder changed a built Gateway: %+v", first.Spec.Listeners)
+	}
+	if second.Spec.Listeners[0].Port != 80 || second.Spec.Listeners[1].Port != 81 {
+		t.Errorf("modifying a built Gateway changed the builder: %+v", second.Spec.Listeners)
+	}
+}
+
+// TestDefaults checks that builders set the defaults of the CRD schemas,
+// both on their own and when every builder method is used.
+func TestDefaults(t *testing.T) {
+	admitted := Condition(string(v1alpha1.ConditionRouteAdmitted), metav1.ConditionTrue, "Admitted")
+	routeStatus := RouteStatus().Gateway("infra", "gw", admitted)
+	secret := Secret("cert")
+
+	objects := []runtime.Object{
+		NewGatewayClass("acme", "example.com/gateway").Build(),
+		NewGatewayClass("acme", "example.com/gateway").
+			Labels(map[string]string{"a": "b"}).
+			AllowedGatewayNamespaces(map[string]string{"gateways": "true"}).
+			Parameters("example.com", "Config", "acme").
+			Status(GatewayClassStatus().
+				Condition(Condition(string(v1alpha1.GatewayClassConditionStatusInvalidParameters), metav1.ConditionFalse, "Valid")).
+				ProvisionedGateway("infra", "gw")).
+			Build(),
+
+		NewGateway("infra", "gw", "acme").Build(),
+		NewGateway("infra", "gw", "acme").
+			Annotations(map[string]string{"a": "b"}).
+			Listener(
+				Listener(v1alpha1.HTTPProtocolType, 80).Domain("example.com").RoutesFromAll(),
+				Listener(v1alpha1.HTTPSProtocolType, 443).Hostname("www.example.com").
+					RoutesFromNamespaces(map[string]string{"expose": "true"}).
+					RouteLabels(map[string]string{"app": "web"}).
+					TLS(TerminateTLS(secret).AllowRouteOverride().
+						Versions(v1alpha1.TLSVersion12, "").
+						CipherSuites("TLS_AES_128_GCM_SHA256").
+						ALPNProtocols("h2").
+						ClientValidation("core", "Secret", "ca").
+						Option("a", "b")),
+				Listener(v1alpha1.TLSProtocolType, 8443).TLS(PassthroughTLS()),
+				Listener(v1alpha1.TCPProtocolType, 9000).Routes("example.com", "CustomRoute"),
+				Listener(v1alpha1.UDPProtocolType, 53),
+			).
+			Address(v1alpha1.IPAddressType, "10.0.0.1").
+			Status(GatewayStatus().
+				Address(v1alpha1.IPAddressType, "10.0.0.1").
+				Condition(Condition(string(v1alpha1.GatewayConditionReady), metav1.ConditionTrue, "Ready").ObservedGeneration(1)).
+				Listener(80).
+				Listener(443, Condition(string(v1alpha1.ListenerConditionReady), metav1.ConditionTrue, "Ready").
+					LastTransitionTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)))).
+			Build(),
+
+		NewHTTPRoute("web", "route").Build(),
+		NewHTTPRoute("web", "route").
+			Labels(map[string]string{"app": "web"}).
+			Gateway("infra", "gw").
+			Hostnames("www.example.com").
+			TLS(secret).
+			Rule(
+				Match().PathExact("/a").HeaderMatcher("x", v1alpha1.HeaderMatchPresent, "").Method("GET").QueryParam("q", "1"),
+				Match().PathRegularExpression("/b.*").Extension("example.com", "Match", "m"),
+			).
+			ForwardTo(
+				Service("svc", 8080).Namespace("other").Filter(RequestHeaders().Set("a", "b")),
+				Backend("example.com", "Bucket", "static").Port(80),
+			).
+			Filter(
+				RequestHeaders().Add("a", "b").Remove("c"),
+				ResponseHeaders().Set("a", "b"),
+				Mirror("mirror", 8080),
+				MirrorBackend("example.com", "Sink", "sink"),
+				Redirect().Hostname("example.org").Port(443).Path("/").StatusCode(301),
+				Rewrite().Hostname("example.org").ReplacePrefixMatch("/"),
+				ExtensionFilter("example.com", "Filter", "f"),
+			).
+			Timeout(time.Second).
+			BackendTimeout(time.Second).
+			Retry(Retry(3).PerTryTimeout(time.Second).OnStatus(503).OnConnectFailure().Backoff(time.Second, 0)).
+			Status(routeStatus).
+			Build(),
+
+		NewGRPCRoute("web", "route").Build(),
+		NewGRPCRoute("web", "route").
+			AllowGateways(v1alpha1.GatewayAllowAll).
+			Hostnames("grpc.example.com").
+			Rule(GRPCMatch().Service("foo.Bar").Method("Get").RegularExpression().Header("x", "y")).
+			ForwardTo(Service("svc", 9000)).
+			Status(routeStatus).
+			Build(),
+
+		NewTCPRoute("web", "route").Build(),
+		NewTCPRoute("web", "route").
+			Rule(TCPMatch().SourceCIDRs("10.0.0.0/8").Port(9000).Extension("example.com", "Match", "m")).
+			ForwardTo(Service("svc", 9000)).
+			Status(routeStatus).
+			Build(),
+
+		NewTLSRoute("web", "route").Build(),
+		NewTLSRoute("web", "route").
+			Rule(TLSMatch().SNIs("example.com").ALPNProtocols("h2").Port(8443)).
+			ForwardTo(Service("svc", 8443)).
+			Status(routeStatus).
+			Build(),
+
+		NewUDPRoute("web", "route").Build(),
+		NewUDPRoute("web", "route").
+			Rule(UDPMatch().SourceCIDRs("10.0.0.0/8").Port(53)).
+			ForwardTo(Service("dns", 53)).
+			Status(routeStatus).
+			Build(),
+
+		NewBackendPolicy("web", "policy").Build(),
+		NewBackendPolicy("web", "policy").
+			Service("svc", 8080).
+			Backend("example.com", "Bucket", "static").
+			TLS(BackendTLS("ca").ClientCertificate("client").Versions("", v1alpha1.TLSVersion13).Option("a", "b")).
+			RingHash(v1alpha1.HashKeyCookie, "session").
+			HealthCheck(HTTPHealthCheck("/healthz", time.Second).Hostname("svc").Timeout(time.Second)).
+			CookieAffinity("session", time.Hour).
+			MaxConnections(1).
+			MaxPendingRequests(1).
+			MaxRequestsPerConnection(1).
+			IdleTimeout(time.Minute).
+			OutlierDetection(30 * time.Second).
+			Status(BackendPolicyStatus().
+				Condition(Condition(string(v1alpha1.ConditionUnsupportedSettings), metav1.ConditionTrue, "Unsupported")).
+				UnsupportedSettings("connectionPool")).
+			Build(),
+
+		NewReferenceGrant("other", "grant").Build(),
+		NewReferenceGrant("other", "grant").
+			From(v1alpha1.GroupName, "HTTPRoute", "web").
+			To("core", "Service").
+			ToName("core", "Secret", "cert").
+			Build(),
+	}
+	for _, obj := range objects {
+		apitesting.CheckDefaulted(t, v1alpha1.Install, "../../config/crd/bases", obj)
+	}
+}
+
+func TestZeroMaxEjectionPercent(t *testing.T) {
+	p := NewBackendPolicy("web", "policy").OutlierDetection(30 * time.Second).Build()
+	zero := int32(0)
+	p.Spec.OutlierDetection.MaxEjectionPercent = &zero
+	apitesting.CheckDefaulted(t, v1alpha1.Install, "../../config/crd/bases", p)
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builders
+
+import (
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// HTTPFilter is implemented by the builders of HTTPRoute filters.
+type HTTPFilter interface {
+	Build() v1alpha1.HTTPRouteFilter
+}
+
+// HeaderFilterBuilder builds a filter that modifies request or response
+// headers.
+type HeaderFilterBuilder struct {
+	response bool
+	h        v1alpha1.HTTPRequestHeaderFilter
+}
+
+// RequestHeaders starts a filter that modifies request headers.
+func RequestHeaders() *HeaderFilterBuilder {
+	return &HeaderFilterBuilder{}
+}
+
+// ResponseHeaders starts a filter that modifies response headers.
+func ResponseHeaders() *HeaderFilterBuilder {
+	return &HeaderFilterBuilder{response: true}
+}
+
+// Set overwrites the header name with value.
+func (b *HeaderFilterBuilder) Set(name, value string) *HeaderFilterBuilder {
+	if b.h.Set == nil {
+		b.h.Set = map[string]string{}
+	}
+	b.h.Set[name] = value
+	return b
+}
+
+// Add appends value to the header name.
+func (b *HeaderFilterBuilder) Add(name, value string) *HeaderFilterBuilder {
+	if b.h.Add == nil {
+		b.h.Add = map[string]string{}
+	}
+	b.h.Add[name] = value
+	return b
+}
+
+// Remove removes the named headers.
+func (b *HeaderFilterBuilder) Remove(names ...string) *HeaderFilterBuilder {
+	b.h.Remove = append(b.h.Remove, names...)
+	return b
+}
+
+// Build returns the filter.
+func (b *HeaderFilterBuilder) Build() v1alpha1.HTTPRouteFilter {
+	h := b.h.DeepCopy()
+	if b.response {
+		return v1alpha1.HTTPRouteFilter{
+			Type:           v1alpha1.FilterTypeHTTPResponseHeader,
+			ResponseHeader: (*v1alpha1.HTTPResponseHeaderFilter)(h),
+		}
+	}
+	return v1alpha1.HTTPRouteFilter{
+		Type:          v1alpha1.FilterTypeHTTPRequestHeader,
+		RequestHeader: h,
+	}
+}
+
+// MirrorFilterBuilder builds a filter that mirrors requests to a backend.
+type MirrorFilterBuilder struct {
+	m v1alpha1.HTTPRequestMirrorFilter
+}
+
+// Mirror starts a filter that mirrors requests to the named Service on
+// port.
+func Mirror(service string, port int32) *MirrorFilterBuilder {
+	return &MirrorFilterBuilder{m: v1alpha1.HTTPRequestMirrorFilter{ServiceName: &service, Port: &port}}
+}
+
+// MirrorBackend starts a filter that mirrors requests to an object of the
+// given group and kind.
+func MirrorBackend(group, kind, name string) *MirrorFilterBuilder {
+	return &MirrorFilterBuilder{m: v1alpha1.HTTPRequestMirrorFilter{
+		BackendRef: &v1alpha1.LocalObjectReference{Group: group, Kind: kind, Name: name},
+	}}
+}
+
+// Build returns the filter.
+func (b *MirrorFilterBuilder) Build() v1alpha1.HTTPRouteFilter {
+	return v1alpha1.HTTPRouteFilter{
+		Type:          v1alpha1.FilterTypeHTTPRequestMirror,
+		RequestMirror: b.m.DeepCopy(),
+	}
+}
+
+// RedirectFilterBuilder builds a filter that redirects requests.
+type RedirectFilterBuilder struct {
+	r v1alpha1.HTTPRequestRedirectFilter
+}
+
+// Redirect starts a filter that redirects requests with status 302.
+func Redirect() *RedirectFilterBuilder {
+	code := int32(302)
+	return &RedirectFilterBuilder{r: v1alpha1.HTTPRequestRedirectFilter{StatusCode: &code}}
+}
+
+// Scheme sets the scheme of the redirect location.
+func (b *RedirectFilterBuilder) Scheme(scheme string) *RedirectFilterBuilder {
+	b.r.Scheme = &scheme
+	return b
+}
+
+// Hostname sets the hostname of the redirect location.
+func (b *RedirectFilterBuilder) Hostname(hostname string) *RedirectFilterBuilder {
+	b.r.Hostname = &hostname
+	return b
+}
+
+// Port sets the port of the redirect location.
+func (b *RedirectFilterBuilder) Port(port int32) *RedirectFilterBuilder {
+	b.r.Port = &port
+	return b
+}
+
+// Path sets the path of the redirect location.
+func (b *RedirectFilterBuilder) Path(path string) *RedirectFilterBuilder {
+	b.r.Path = &path
+	return b
+}
+
+// StatusCode sets the status code of the redirect response.
+func (b *RedirectFilterBuilder) StatusCode(code int32) *RedirectFilterBuilder {
+	b.r.StatusCode = &code
+	return b
+}
+
+// Build returns the filter.
+func (b *RedirectFilterBuilder) Build() v1alpha1.HTTPRouteFilter {
+	return v1alpha1.HTTPRouteFilter{
+		Type:            v1alpha1.FilterTypeHTTPRequestRedirect,
+		RequestRedirect: b.r.DeepCopy(),
+	}
+}
+
+// RewriteFilterBuilder builds a filter that rewrites request URLs.
+type RewriteFilterBuilder struct {
+	r v1alpha1.HTTPURLRewriteFilter
+}
+
+// Rewrite starts a filter that rewrites request URLs.
+func Rewrite() *RewriteFilterBuilder {
+	return &RewriteFilterBuilder{}
+}
+
+// Hostname replaces the hostname of requests.
+func (b *RewriteFilterBuilder) Hostname(hostname string) *RewriteFilterBuilder {
+	b.r.Hostname = &hostname
+	return b
+}
+
+// ReplaceFullPath replaces the whole path of requests with path.
+func (b *RewriteFilterBuilder) ReplaceFullPath(path string) *RewriteFilterBuilder {
+	b.r.Path = &v1alpha1.HTTPPathModifier{Type: v1alpha1.FullPathHTTPPathModifier, ReplaceFullPath: &path}
+	return b
+}
+
+// ReplacePrefixMatch replaces the matched path prefix of requests with
+// prefix.
+func (b *RewriteFilterBuilder) ReplacePrefixMatch(prefix string) *RewriteFilterBuilder {
+	b.r.Path = &v1alpha1.HTTPPathModifier{Type: v1alpha1.PrefixMatchHTTPPathModifier, ReplacePrefixMatch: &prefix}
+	return b
+}
+
+// Build returns the filter.
+func (b *RewriteFilterBuilder) Build() v1alpha1.HTTPRouteFilter {
+	return v1alpha1.HTTPRouteFilter{
+		Type:       v1alpha1.FilterTypeURLRewrite,
+		URLRewrite: b.r.DeepCopy(),
+	}
+}
+
+// ExtensionFilterBuilder builds an implementation-specific filter.
+type ExtensionFilterBuilder struct {
+	ref v1alpha1.LocalObjectReference
+}
+
+// ExtensionFilter starts a filter configured by an object of the given
+// group and kind.
+func ExtensionFilter(group, kind, name string) *ExtensionFilterBuilder {
+	return &ExtensionFilterBuilder{ref: v1alpha1.LocalObjectReference{Group: group, Kind: kind, Name: name}}
+}
+
+// Build returns the filter.
+func (b *ExtensionFilterBuilder) Build() v1alpha1.HTTPRouteFilter {
+	ref := b.ref
+	return v1alpha1.HTTPRouteFilter{
+		Type:         v1alpha1.FilterTypeImplementationSpecific,
+		ExtensionRef: &ref,
+	}
+}
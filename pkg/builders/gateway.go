@@ -0,0 +1,283 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builders
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// GatewayBuilder builds a Gateway.
+type GatewayBuilder struct {
+	g v1alpha1.Gateway
+}
+
+// NewGateway starts a Gateway of the given class without listeners. Its
+// status is the one the CRD schema sets on new Gateways: not scheduled,
+// waiting for a controller.
+func NewGateway(namespace, name, className string) *GatewayBuilder {
+	return &GatewayBuilder{g: v1alpha1.Gateway{
+		ObjectMeta: objectMeta(namespace, name),
+		Spec: v1alpha1.GatewaySpec{
+			GatewayClassName: className,
+			Listeners:        []v1alpha1.Listener{},
+		},
+		Status: GatewayStatus().Condition(
+			Condition(string(v1alpha1.GatewayConditionScheduled), metav1.ConditionFalse, string(v1alpha1.GatewayReasonNotReconciled)).
+				Message("Waiting for controller"),
+		).Build(),
+	}}
+}
+
+// Labels adds labels to the Gateway.
+func (b *GatewayBuilder) Labels(labels map[string]string) *GatewayBuilder {
+	addLabels(&b.g.ObjectMeta, labels)
+	return b
+}
+
+// Annotations adds annotations to the Gateway.
+func (b *GatewayBuilder) Annotations(annotations map[string]string) *GatewayBuilder {
+	addAnnotations(&b.g.ObjectMeta, annotations)
+	return b
+}
+
+// Listener adds listeners to the Gateway.
+func (b *GatewayBuilder) Listener(listeners ...*ListenerBuilder) *GatewayBuilder {
+	for _, l := range listeners {
+		b.g.Spec.Listeners = append(b.g.Spec.Listeners, l.Build())
+	}
+	return b
+}
+
+// Address requests an address of the given type for the Gateway.
+func (b *GatewayBuilder) Address(addressType v1alpha1.AddressType, value string) *GatewayBuilder {
+	b.g.Spec.Addresses = append(b.g.Spec.Addresses, v1alpha1.GatewayAddress{Type: addressType, Value: value})
+	return b
+}
+
+// Status replaces the status of the Gateway.
+func (b *GatewayBuilder) Status(status *GatewayStatusBuilder) *GatewayBuilder {
+	b.g.Status = status.Build()
+	return b
+}
+
+// Build returns the Gateway.
+func (b *GatewayBuilder) Build() *v1alpha1.Gateway {
+	return b.g.DeepCopy()
+}
+
+// ListenerBuilder builds a Gateway listener.
+type ListenerBuilder struct {
+	l v1alpha1.Listener
+}
+
+// routeKinds are the route kinds that listeners bind by default, by
+// protocol.
+var routeKinds = map[v1alpha1.ProtocolType]string{
+	v1alpha1.HTTPProtocolType:  "HTTPRoute",
+	v1alpha1.HTTPSProtocolType: "HTTPRoute",
+	v1alpha1.TLSProtocolType:   "TLSRoute",
+	v1alpha1.TCPProtocolType:   "TCPRoute",
+	v1alpha1.UDPProtocolType:   "UDPRoute",
+}
+
+// Listener starts a listener for any hostname on the given protocol and
+// port. It binds routes in the namespace of the Gateway, of the kind that
+// serves the protocol: HTTPRoutes for HTTP and HTTPS, and TLSRoutes,
+// TCPRoutes and UDPRoutes for the others.
+func Listener(protocol v1alpha1.ProtocolType, port int32) *ListenerBuilder {
+	return &ListenerBuilder{l: v1alpha1.Listener{
+		Hostname: v1alpha1.HostnameMatch{Match: v1alpha1.HostnameMatchAny},
+		Port:     port,
+		Protocol: protocol,
+		Routes: v1alpha1.RouteBindingSelector{
+			RouteNamespaces: v1alpha1.RouteNamespaces{From: v1alpha1.RouteSelectSame},
+			Group:           v1alpha1.GroupName,
+			Kind:            routeKinds[protocol],
+		},
+	}}
+}
+
+// Hostname accepts only requests for exactly hostname.
+func (b *ListenerBuilder) Hostname(hostname string) *ListenerBuilder {
+	b.l.Hostname = v1alpha1.HostnameMatch{Match: v1alpha1.HostnameMatchExact, Name: hostname}
+	return b
+}
+
+// Domain accepts only requests for hostnames in domain.
+func (b *ListenerBuilder) Domain(domain string) *ListenerBuilder {
+	b.l.Hostname = v1alpha1.HostnameMatch{Match: v1alpha1.HostnameMatchDomain, Name: domain}
+	return b
+}
+
+// Routes binds routes of the given group and kind.
+func (b *ListenerBuilder) Routes(group, kind string) *ListenerBuilder {
+	b.l.Routes.Group = group
+	b.l.Routes.Kind = kind
+	return b
+}
+
+// RoutesFromAll binds routes in all namespaces.
+func (b *ListenerBuilder) RoutesFromAll() *ListenerBuilder {
+	b.l.Routes.RouteNamespaces = v1alpha1.RouteNamespaces{From: v1alpha1.RouteSelectAll}
+	return b
+}
+
+// RoutesFromNamespaces binds routes in the namespaces with the given
+// labels.
+func (b *ListenerBuilder) RoutesFromNamespaces(labels map[string]string) *ListenerBuilder {
+	b.l.Routes.RouteNamespaces = v1alpha1.RouteNamespaces{
+		From:     v1alpha1.RouteSelectSelector,
+		Selector: metav1.LabelSelector{MatchLabels: labels},
+	}
+	return b
+}
+
+// RouteLabels binds only routes with the given labels.
+func (b *ListenerBuilder) RouteLabels(labels map[string]string) *ListenerBuilder {
+	b.l.Routes.RouteSelector = metav1.LabelSelector{MatchLabels: labels}
+	return b
+}
+
+// TLS sets the TLS configuration of the listener.
+func (b *ListenerBuilder) TLS(tls *GatewayTLSBuilder) *ListenerBuilder {
+	t := tls.Build()
+	b.l.TLS = &t
+	return b
+}
+
+// Build returns the listener.
+func (b *ListenerBuilder) Build() v1alpha1.Listener {
+	return *b.l.DeepCopy()
+}
+
+// GatewayTLSBuilder builds the TLS configuration of a listener.
+type GatewayTLSBuilder struct {
+	t v1alpha1.GatewayTLSConfig
+}
+
+// TerminateTLS starts a configuration that terminates TLS with the given
+// certificate. Routes may not override the certificate.
+func TerminateTLS(certificate v1alpha1.CertificateObjectReference) *GatewayTLSBuilder {
+	return &GatewayTLSBuilder{t: v1alpha1.GatewayTLSConfig{
+		Mode:           v1alpha1.TLSModeTerminate,
+		CertificateRef: certificate,
+		RouteOverride:  v1alpha1.TLSOverridePolicy{Certificate: v1alpha1.TLSRouteOverrideDeny},
+	}}
+}
+
+// PassthroughTLS starts a configuration that passes TLS connections
+// through to the backends.
+func PassthroughTLS() *GatewayTLSBuilder {
+	return &GatewayTLSBuilder{t: v1alpha1.GatewayTLSConfig{
+		Mode:          v1alpha1.TLSModePassthrough,
+		RouteOverride: v1alpha1.TLSOverridePolicy{Certificate: v1alpha1.TLSRouteOverrideDeny},
+	}}
+}
+
+// AllowRouteOverride lets routes override the certificate.
+func (b *GatewayTLSBuilder) AllowRouteOverride() *GatewayTLSBuilder {
+	b.t.RouteOverride.Certificate = v1alpha1.TLSROuteOVerrideAllow
+	return b
+}
+
+// Versions limits the accepted TLS versions. An empty version leaves that
+// bound to the implementation.
+func (b *GatewayTLSBuilder) Versions(min, max v1alpha1.TLSVersion) *GatewayTLSBuilder {
+	b.t.MinVersion, b.t.MaxVersion = nil, nil
+	if min != "" {
+		b.t.MinVersion = &min
+	}
+	if max != "" {
+		b.t.MaxVersion = &max
+	}
+	return b
+}
+
+// CipherSuites limits the accepted cipher suites.
+func (b *GatewayTLSBuilder) CipherSuites(suites ...string) *GatewayTLSBuilder {
+	b.t.CipherSuites = append(b.t.CipherSuites, suites...)
+	return b
+}
+
+// ALPNProtocols sets the protocols offered in the ALPN extension.
+func (b *GatewayTLSBuilder) ALPNProtocols(protocols ...string) *GatewayTLSBuilder {
+	b.t.ALPNProtocols = append(b.t.ALPNProtocols, protocols...)
+	return b
+}
+
+// ClientValidation requires clients to present a certificate signed by
+// one of the CAs in the referenced object.
+func (b *GatewayTLSBuilder) ClientValidation(group, kind, name string) *GatewayTLSBuilder {
+	b.t.ClientValidation = &v1alpha1.TLSClientValidation{
+		Mode:             v1alpha1.ClientValidationRequire,
+		CACertificateRef: v1alpha1.LocalObjectReference{Group: group, Kind: kind, Name: name},
+	}
+	return b
+}
+
+// Option sets an implementation-specific option.
+func (b *GatewayTLSBuilder) Option(name, value string) *GatewayTLSBuilder {
+	if b.t.Options == nil {
+		b.t.Options = map[string]string{}
+	}
+	b.t.Options[name] = value
+	return b
+}
+
+// Build returns the TLS configuration.
+func (b *GatewayTLSBuilder) Build() v1alpha1.GatewayTLSConfig {
+	return *b.t.DeepCopy()
+}
+
+// GatewayStatusBuilder builds the status of a Gateway.
+type GatewayStatusBuilder struct {
+	s v1alpha1.GatewayStatus
+}
+
+// GatewayStatus starts an empty Gateway status.
+func GatewayStatus() *GatewayStatusBuilder {
+	return &GatewayStatusBuilder{s: v1alpha1.GatewayStatus{Addresses: []v1alpha1.GatewayAddress{}}}
+}
+
+// Address adds an address assigned to the Gateway.
+func (b *GatewayStatusBuilder) Address(addressType v1alpha1.AddressType, value string) *GatewayStatusBuilder {
+	b.s.Addresses = append(b.s.Addresses, v1alpha1.GatewayAddress{Type: addressType, Value: value})
+	return b
+}
+
+// Condition adds conditions of the Gateway.
+func (b *GatewayStatusBuilder) Condition(conditions ...*ConditionBuilder) *GatewayStatusBuilder {
+	b.s.Conditions = append(b.s.Conditions, buildConditions(conditions)...)
+	return b
+}
+
+// Listener adds the status of the listener on port.
+func (b *GatewayStatusBuilder) Listener(port int32, conditions ...*ConditionBuilder) *GatewayStatusBuilder {
+	c := buildConditions(conditions)
+	if c == nil {
+		c = []metav1.Condition{}
+	}
+	b.s.Listeners = append(b.s.Listeners, v1alpha1.ListenerStatus{Port: port, Conditions: c})
+	return b
+}
+
+// Build returns the Gateway status.
+func (b *GatewayStatusBuilder) Build() v1alpha1.GatewayStatus {
+	return *b.s.DeepCopy()
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builders
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// GatewayClassBuilder builds a GatewayClass.
+type GatewayClassBuilder struct {
+	c v1alpha1.GatewayClass
+}
+
+// NewGatewayClass starts a GatewayClass managed by controller. Its status
+// is the one the CRD schema sets on new GatewayClasses: waiting for the
+// controller to validate the parameters.
+func NewGatewayClass(name, controller string) *GatewayClassBuilder {
+	return &GatewayClassBuilder{c: v1alpha1.GatewayClass{
+		ObjectMeta: objectMeta("", name),
+		Spec:       v1alpha1.GatewayClassSpec{Controller: controller},
+		Status: GatewayClassStatus().Condition(
+			Condition(string(v1alpha1.GatewayClassConditionStatusInvalidParameters), metav1.ConditionUnknown, "Waiting").
+				Message("Waiting for controller"),
+		).Build(),
+	}}
+}
+
+// Labels adds labels to the GatewayClass.
+func (b *GatewayClassBuilder) Labels(labels map[string]string) *GatewayClassBuilder {
+	addLabels(&b.c.ObjectMeta, labels)
+	return b
+}
+
+// Annotations adds annotations to the GatewayClass.
+func (b *GatewayClassBuilder) Annotations(annotations map[string]string) *GatewayClassBuilder {
+	addAnnotations(&b.c.ObjectMeta, annotations)
+	return b
+}
+
+// AllowedGatewayNamespaces limits the class to Gateways in the
+// namespaces with the given labels.
+func (b *GatewayClassBuilder) AllowedGatewayNamespaces(labels map[string]string) *GatewayClassBuilder {
+	b.c.Spec.AllowedGatewayNamespaces = metav1.LabelSelector{MatchLabels: labels}
+	return b
+}
+
+// Parameters references the object that configures the class.
+func (b *GatewayClassBuilder) Parameters(group, kind, name string) *GatewayClassBuilder {
+	b.c.Spec.ParametersRef = &v1alpha1.GatewayClassParametersObjectReference{Group: group, Kind: kind, Name: name}
+	return b
+}
+
+// Status replaces the status of the GatewayClass.
+func (b *GatewayClassBuilder) Status(status *GatewayClassStatusBuilder) *GatewayClassBuilder {
+	b.c.Status = status.Build()
+	return b
+}
+
+// Build returns the GatewayClass.
+func (b *GatewayClassBuilder) Build() *v1alpha1.GatewayClass {
+	return b.c.DeepCopy()
+}
+
+// GatewayClassStatusBuilder builds the status of a GatewayClass.
+type GatewayClassStatusBuilder struct {
+	s v1alpha1.GatewayClassStatus
+}
+
+// GatewayClassStatus starts an empty GatewayClass status.
+func GatewayClassStatus() *GatewayClassStatusBuilder {
+	return &GatewayClassStatusBuilder{}
+}
+
+// Condition adds conditions of the GatewayClass.
+func (b *GatewayClassStatusBuilder) Condition(conditions ...*ConditionBuilder) *GatewayClassStatusBuilder {
+	b.s.Conditions = append(b.s.Conditions, buildConditions(conditions)...)
+	return b
+}
+
+// ProvisionedGateway records that the Gateway namespace/name was
+// provisioned from the class.
+func (b *GatewayClassStatusBuilder) ProvisionedGateway(namespace, name string) *GatewayClassStatusBuilder {
+	b.s.ProvisionedGateways = append(b.s.ProvisionedGateways, v1alpha1.GatewayReference{Namespace: namespace, Name: name})
+	return b
+}
+
+// Build returns the GatewayClass status.
+func (b *GatewayClassStatusBuilder) Build() v1alpha1.GatewayClassStatus {
+	return *b.s.DeepCopy()
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builders
+
+import (
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// GRPCRouteBuilder builds a GRPCRoute.
+type GRPCRouteBuilder struct {
+	r v1alpha1.GRPCRoute
+}
+
+// NewGRPCRoute starts a GRPCRoute without rules that only Gateways in its
+// own namespace may use.
+func NewGRPCRoute(namespace, name string) *GRPCRouteBuilder {
+	return &GRPCRouteBuilder{r: v1alpha1.GRPCRoute{
+		ObjectMeta: objectMeta(namespace, name),
+		Spec: v1alpha1.GRPCRouteSpec{
+			Gateways: defaultRouteGateways(),
+			Rules:    []v1alpha1.GRPCRouteRule{},
+		},
+	}}
+}
+
+// Labels adds labels to the route.
+func (b *GRPCRouteBuilder) Labels(labels map[string]string) *GRPCRouteBuilder {
+	addLabels(&b.r.ObjectMeta, labels)
+	return b
+}
+
+// Annotations adds annotations to the route.
+func (b *GRPCRouteBuilder) Annotations(annotations map[string]string) *GRPCRouteBuilder {
+	addAnnotations(&b.r.ObjectMeta, annotations)
+	return b
+}
+
+// AllowGateways sets which Gateways may use the route.
+func (b *GRPCRouteBuilder) AllowGateways(allow v1alpha1.GatewayAllowType) *GRPCRouteBuilder {
+	b.r.Spec.Gateways.Allow = allow
+	return b
+}
+
+// Gateway allows the Gateway namespace/name to use the route, in addition
+// to the Gateways added before.
+func (b *GRPCRouteBuilder) Gateway(namespace, name string) *GRPCRouteBuilder {
+	addGateway(&b.r.Spec.Gateways, namespace, name)
+	return b
+}
+
+// Hostnames adds hostnames to the route.
+func (b *GRPCRouteBuilder) Hostnames(hostnames ...string) *GRPCRouteBuilder {
+	for _, h := range hostnames {
+		b.r.Spec.Hostnames = append(b.r.Spec.Hostnames, v1alpha1.HTTPRouteHostname(h))
+	}
+	return b
+}
+
+// Rule starts a new rule with the given matches. A rule without matches
+// matches all calls.
+func (b *GRPCRouteBuilder) Rule(matches ...*GRPCMatchBuilder) *GRPCRouteBuilder {
+	rule := v1alpha1.GRPCRouteRule{}
+	for _, m := range matches {
+		rule.Matches = append(rule.Matches, m.Build())
+	}
+	b.r.Spec.Rules = append(b.r.Spec.Rules, rule)
+	return b
+}
+
+// ForwardTo adds backends to the last rule, starting a rule that matches
+// all calls if the route has none.
+func (b *GRPCRouteBuilder) ForwardTo(backends ...*ForwardToBuilder) *GRPCRouteBuilder {
+	if len(b.r.Spec.Rules) == 0 {
+		b.Rule()
+	}
+	rule := &b.r.Spec.Rules[len(b.r.Spec.Rules)-1]
+	rule.ForwardTo = append(rule.ForwardTo, buildForwardTo(backends)...)
+	return b
+}
+
+// Status sets the status of the route.
+func (b *GRPCRouteBuilder) Status(status *RouteStatusBuilder) *GRPCRouteBuilder {
+	b.r.Status = v1alpha1.GRPCRouteStatus{RouteStatus: status.Build()}
+	return b
+}
+
+// Build returns the route.
+func (b *GRPCRouteBuilder) Build() *v1alpha1.GRPCRoute {
+	return b.r.DeepCopy()
+}
+
+// GRPCMatchBuilder builds a match of a GRPCRoute rule.
+type GRPCMatchBuilder struct {
+	m v1alpha1.GRPCRouteMatch
+}
+
+// GRPCMatch starts a match of all calls.
+func GRPCMatch() *GRPCMatchBuilder {
+	return &GRPCMatchBuilder{}
+}
+
+func (b *GRPCMatchBuilder) method() *v1alpha1.GRPCMethodMatch {
+	if b.m.Method == nil {
+		b.m.Method = &v1alpha1.GRPCMethodMatch{Type: v1alpha1.GRPCMethodMatchExact}
+	}
+	return b.m.Method
+}
+
+// Service matches calls to the given fully qualified service.
+func (b *GRPCMatchBuilder) Service(service string) *GRPCMatchBuilder {
+	b.method().Service = &service
+	return b
+}
+
+// Method matches calls of the given method.
+func (b *GRPCMatchBuilder) Method(method string) *GRPCMatchBuilder {
+	b.method().Method = &method
+	return b
+}
+
+// RegularExpression matches the service and method as regular
+// expressions instead of exactly.
+func (b *GRPCMatchBuilder) RegularExpression() *GRPCMatchBuilder {
+	b.method().Type = v1alpha1.GRPCMethodMatchRegularExpression
+	return b
+}
+
+// Header matches calls with the metadata key name set to exactly value.
+func (b *GRPCMatchBuilder) Header(name, value string) *GRPCMatchBuilder {
+	b.m.Headers = append(b.m.Headers, v1alpha1.HTTPHeaderMatcher{Name: name, Type: v1alpha1.HeaderMatchExact, Value: value})
+	return b
+}
+
+// Build returns the match.
+func (b *GRPCMatchBuilder) Build() v1alpha1.GRPCRouteMatch {
+	return *b.m.DeepCopy()
+}
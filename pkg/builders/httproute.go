@@ -0,0 +1,287 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builders
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// HTTPRouteBuilder builds an HTTPRoute.
+type HTTPRouteBuilder struct {
+	r v1alpha1.HTTPRoute
+}
+
+// NewHTTPRoute starts an HTTPRoute without rules that only Gateways in its
+// own namespace may use.
+func NewHTTPRoute(namespace, name string) *HTTPRouteBuilder {
+	return &HTTPRouteBuilder{r: v1alpha1.HTTPRoute{
+		ObjectMeta: objectMeta(namespace, name),
+		Spec: v1alpha1.HTTPRouteSpec{
+			Gateways: defaultRouteGateways(),
+			Rules:    []v1alpha1.HTTPRouteRule{},
+		},
+	}}
+}
+
+// Labels adds labels to the route.
+func (b *HTTPRouteBuilder) Labels(labels map[string]string) *HTTPRouteBuilder {
+	addLabels(&b.r.ObjectMeta, labels)
+	return b
+}
+
+// Annotations adds annotations to the route.
+func (b *HTTPRouteBuilder) Annotations(annotations map[string]string) *HTTPRouteBuilder {
+	addAnnotations(&b.r.ObjectMeta, annotations)
+	return b
+}
+
+// AllowGateways sets which Gateways may use the route.
+func (b *HTTPRouteBuilder) AllowGateways(allow v1alpha1.GatewayAllowType) *HTTPRouteBuilder {
+	b.r.Spec.Gateways.Allow = allow
+	return b
+}
+
+// Gateway allows the Gateway namespace/name to use the route, in addition
+// to the Gateways added before.
+func (b *HTTPRouteBuilder) Gateway(namespace, name string) *HTTPRouteBuilder {
+	addGateway(&b.r.Spec.Gateways, namespace, name)
+	return b
+}
+
+// Hostnames adds hostnames to the route.
+func (b *HTTPRouteBuilder) Hostnames(hostnames ...string) *HTTPRouteBuilder {
+	for _, h := range hostnames {
+		b.r.Spec.Hostnames = append(b.r.Spec.Hostnames, v1alpha1.HTTPRouteHostname(h))
+	}
+	return b
+}
+
+// TLS sets the certificate the route serves for its hostnames.
+func (b *HTTPRouteBuilder) TLS(certificate v1alpha1.CertificateObjectReference) *HTTPRouteBuilder {
+	b.r.Spec.TLS = &v1alpha1.RouteTLSConfig{CertificateRef: certificate}
+	return b
+}
+
+// Rule starts a new rule with the given matches. A rule without matches
+// matches all requests, with a path prefix of "/".
+func (b *HTTPRouteBuilder) Rule(matches ...*HTTPMatchBuilder) *HTTPRouteBuilder {
+	rule := v1alpha1.HTTPRouteRule{}
+	for _, m := range matches {
+		rule.Matches = append(rule.Matches, m.Build())
+	}
+	if len(rule.Matches) == 0 {
+		rule.Matches = []v1alpha1.HTTPRouteMatch{Match().Build()}
+	}
+	b.r.Spec.Rules = append(b.r.Spec.Rules, rule)
+	return b
+}
+
+// rule returns the last rule, starting one if the route has none.
+func (b *HTTPRouteBuilder) rule() *v1alpha1.HTTPRouteRule {
+	if len(b.r.Spec.Rules) == 0 {
+		b.Rule()
+	}
+	return &b.r.Spec.Rules[len(b.r.Spec.Rules)-1]
+}
+
+// ForwardTo adds backends to the last rule.
+func (b *HTTPRouteBuilder) ForwardTo(backends ...*ForwardToBuilder) *HTTPRouteBuilder {
+	rule := b.rule()
+	for _, f := range backends {
+		rule.ForwardTo = append(rule.ForwardTo, f.Build())
+	}
+	return b
+}
+
+// Filter adds filters to the last rule.
+func (b *HTTPRouteBuilder) Filter(filters ...HTTPFilter) *HTTPRouteBuilder {
+	rule := b.rule()
+	for _, f := range filters {
+		rule.Filters = append(rule.Filters, f.Build())
+	}
+	return b
+}
+
+// Timeout sets the timeout of requests matched by the last rule.
+func (b *HTTPRouteBuilder) Timeout(d time.Duration) *HTTPRouteBuilder {
+	rule := b.rule()
+	if rule.Timeouts == nil {
+		rule.Timeouts = &v1alpha1.HTTPRouteTimeouts{}
+	}
+	rule.Timeouts.Request = &metav1.Duration{Duration: d}
+	return b
+}
+
+// BackendTimeout sets the timeout of each request that the last rule
+// sends to a backend.
+func (b *HTTPRouteBuilder) BackendTimeout(d time.Duration) *HTTPRouteBuilder {
+	rule := b.rule()
+	if rule.Timeouts == nil {
+		rule.Timeouts = &v1alpha1.HTTPRouteTimeouts{}
+	}
+	rule.Timeouts.BackendRequest = &metav1.Duration{Duration: d}
+	return b
+}
+
+// Retry sets the retry policy of the last rule.
+func (b *HTTPRouteBuilder) Retry(retry *RetryBuilder) *HTTPRouteBuilder {
+	r := retry.Build()
+	b.rule().Retry = &r
+	return b
+}
+
+// Status sets the status of the route.
+func (b *HTTPRouteBuilder) Status(status *RouteStatusBuilder) *HTTPRouteBuilder {
+	b.r.Status = v1alpha1.HTTPRouteStatus{RouteStatus: status.Build()}
+	return b
+}
+
+// Build returns the route.
+func (b *HTTPRouteBuilder) Build() *v1alpha1.HTTPRoute {
+	return b.r.DeepCopy()
+}
+
+// HTTPMatchBuilder builds a match of an HTTPRoute rule.
+type HTTPMatchBuilder struct {
+	m v1alpha1.HTTPRouteMatch
+}
+
+// Match starts a match of all requests, with a path prefix of "/".
+func Match() *HTTPMatchBuilder {
+	return &HTTPMatchBuilder{m: v1alpha1.HTTPRouteMatch{
+		Path: v1alpha1.HTTPPathMatch{Type: v1alpha1.PathMatchPrefix, Value: "/"},
+	}}
+}
+
+// PathPrefix matches requests whose path starts with prefix.
+func (b *HTTPMatchBuilder) PathPrefix(prefix string) *HTTPMatchBuilder {
+	b.m.Path = v1alpha1.HTTPPathMatch{Type: v1alpha1.PathMatchPrefix, Value: prefix}
+	return b
+}
+
+// PathExact matches requests for exactly path.
+func (b *HTTPMatchBuilder) PathExact(path string) *HTTPMatchBuilder {
+	b.m.Path = v1alpha1.HTTPPathMatch{Type: v1alpha1.PathMatchExact, Value: path}
+	return b
+}
+
+// PathRegularExpression matches requests whose path matches re.
+func (b *HTTPMatchBuilder) PathRegularExpression(re string) *HTTPMatchBuilder {
+	b.m.Path = v1alpha1.HTTPPathMatch{Type: v1alpha1.PathMatchRegularExpression, Value: re}
+	return b
+}
+
+func (b *HTTPMatchBuilder) headers() *v1alpha1.HTTPHeaderMatch {
+	if b.m.Headers == nil {
+		b.m.Headers = &v1alpha1.HTTPHeaderMatch{Type: v1alpha1.HeaderMatchExact}
+	}
+	return b.m.Headers
+}
+
+// Header matches requests with the header name set to exactly value.
+func (b *HTTPMatchBuilder) Header(name, value string) *HTTPMatchBuilder {
+	h := b.headers()
+	if h.Values == nil {
+		h.Values = map[string]string{}
+	}
+	h.Values[name] = value
+	return b
+}
+
+// HeaderMatcher matches requests on the header name with the given match
+// type. Value is ignored by the Present and Absent types.
+func (b *HTTPMatchBuilder) HeaderMatcher(name string, matchType v1alpha1.HeaderMatchType, value string) *HTTPMatchBuilder {
+	h := b.headers()
+	h.Matchers = append(h.Matchers, v1alpha1.HTTPHeaderMatcher{Name: name, Type: matchType, Value: value})
+	return b
+}
+
+// Method matches requests with the given method.
+func (b *HTTPMatchBuilder) Method(method string) *HTTPMatchBuilder {
+	b.m.Method = &v1alpha1.HTTPMethodMatch{Type: v1alpha1.MethodMatchExact, Value: method}
+	return b
+}
+
+// QueryParam matches requests with the query parameter name set to
+// exactly value.
+func (b *HTTPMatchBuilder) QueryParam(name, value string) *HTTPMatchBuilder {
+	if b.m.QueryParams == nil {
+		b.m.QueryParams = &v1alpha1.HTTPQueryParamMatch{Type: v1alpha1.QueryParamMatchExact, Values: map[string]string{}}
+	}
+	b.m.QueryParams.Values[name] = value
+	return b
+}
+
+// Extension adds an implementation-specific match extension.
+func (b *HTTPMatchBuilder) Extension(group, kind, name string) *HTTPMatchBuilder {
+	b.m.ExtensionRef = &v1alpha1.LocalObjectReference{Group: group, Kind: kind, Name: name}
+	return b
+}
+
+// Build returns the match.
+func (b *HTTPMatchBuilder) Build() v1alpha1.HTTPRouteMatch {
+	return *b.m.DeepCopy()
+}
+
+// RetryBuilder builds the retry policy of an HTTPRoute rule.
+type RetryBuilder struct {
+	r v1alpha1.HTTPRetryPolicy
+}
+
+// Retry starts a policy that retries a request up to attempts times.
+func Retry(attempts int32) *RetryBuilder {
+	return &RetryBuilder{r: v1alpha1.HTTPRetryPolicy{Attempts: attempts}}
+}
+
+// PerTryTimeout sets the timeout of each attempt.
+func (b *RetryBuilder) PerTryTimeout(d time.Duration) *RetryBuilder {
+	b.r.PerTryTimeout = &metav1.Duration{Duration: d}
+	return b
+}
+
+// OnStatus retries requests answered with one of the status codes.
+func (b *RetryBuilder) OnStatus(codes ...int32) *RetryBuilder {
+	for _, c := range codes {
+		b.r.RetryOn.StatusCodes = append(b.r.RetryOn.StatusCodes, v1alpha1.HTTPRetryStatusCode(c))
+	}
+	return b
+}
+
+// OnConnectFailure retries requests that failed to connect to a backend.
+func (b *RetryBuilder) OnConnectFailure() *RetryBuilder {
+	b.r.RetryOn.ConnectFailure = true
+	return b
+}
+
+// Backoff waits between attempts, starting at base and growing up to max.
+// A zero max leaves the limit to the implementation.
+func (b *RetryBuilder) Backoff(base, max time.Duration) *RetryBuilder {
+	b.r.Backoff = &v1alpha1.HTTPRetryBackoff{BaseInterval: metav1.Duration{Duration: base}}
+	if max != 0 {
+		b.r.Backoff.MaxInterval = &metav1.Duration{Duration: max}
+	}
+	return b
+}
+
+// Build returns the retry policy.
+func (b *RetryBuilder) Build() v1alpha1.HTTPRetryPolicy {
+	return *b.r.DeepCopy()
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builders
+
+import (
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// ReferenceGrantBuilder builds a ReferenceGrant.
+type ReferenceGrantBuilder struct {
+	g v1alpha1.ReferenceGrant
+}
+
+// NewReferenceGrant starts a ReferenceGrant that allows no references.
+func NewReferenceGrant(namespace, name string) *ReferenceGrantBuilder {
+	return &ReferenceGrantBuilder{g: v1alpha1.ReferenceGrant{
+		ObjectMeta: objectMeta(namespace, name),
+		Spec: v1alpha1.ReferenceGrantSpec{
+			From: []v1alpha1.ReferenceGrantFrom{},
+			To:   []v1alpha1.ReferenceGrantTo{},
+		},
+	}}
+}
+
+// Labels adds labels to the grant.
+func (b *ReferenceGrantBuilder) Labels(labels map[string]string) *ReferenceGrantBuilder {
+	addLabels(&b.g.ObjectMeta, labels)
+	return b
+}
+
+// Annotations adds annotations to the grant.
+func (b *ReferenceGrantBuilder) Annotations(annotations map[string]string) *ReferenceGrantBuilder {
+	addAnnotations(&b.g.ObjectMeta, annotations)
+	return b
+}
+
+// From allows objects of the given group and kind in namespace to
+// reference the objects the grant allows.
+func (b *ReferenceGrantBuilder) From(group, kind, namespace string) *ReferenceGrantBuilder {
+	b.g.Spec.From = append(b.g.Spec.From, v1alpha1.ReferenceGrantFrom{Group: group, Kind: kind, Namespace: namespace})
+	return b
+}
+
+// To allows references to all objects of the given group and kind in the
+// namespace of the grant.
+func (b *ReferenceGrantBuilder) To(group, kind string) *ReferenceGrantBuilder {
+	b.g.Spec.To = append(b.g.Spec.To, v1alpha1.ReferenceGrantTo{Group: group, Kind: kind})
+	return b
+}
+
+// ToName allows references to the named object of the given group and
+// kind in the namespace of the grant.
+func (b *ReferenceGrantBuilder) ToName(group, kind, name string) *ReferenceGrantBuilder {
+	b.g.Spec.To = append(b.g.Spec.To, v1alpha1.ReferenceGrantTo{Group: group, Kind: kind, Name: &name})
+	return b
+}
+
+// Build returns the grant.
+func (b *ReferenceGrantBuilder) Build() *v1alpha1.ReferenceGrant {
+	return b.g.DeepCopy()
+}
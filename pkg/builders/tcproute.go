@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builders
+
+import (
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// TCPRouteBuilder builds a TCPRoute.
+type TCPRouteBuilder struct {
+	r v1alpha1.TCPRoute
+}
+
+// NewTCPRoute starts a TCPRoute without rules that only Gateways in its
+// own namespace may use.
+func NewTCPRoute(namespace, name string) *TCPRouteBuilder {
+	return &TCPRouteBuilder{r: v1alpha1.TCPRoute{
+		ObjectMeta: objectMeta(namespace, name),
+		Spec: v1alpha1.TCPRouteSpec{
+			Gateways: defaultRouteGateways(),
+			Rules:    []v1alpha1.TCPRouteRule{},
+		},
+	}}
+}
+
+// Labels adds labels to the route.
+func (b *TCPRouteBuilder) Labels(labels map[string]string) *TCPRouteBuilder {
+	addLabels(&b.r.ObjectMeta, labels)
+	return b
+}
+
+// Annotations adds annotations to the route.
+func (b *TCPRouteBuilder) Annotations(annotations map[string]string) *TCPRouteBuilder {
+	addAnnotations(&b.r.ObjectMeta, annotations)
+	return b
+}
+
+// AllowGateways sets which Gateways may use the route.
+func (b *TCPRouteBuilder) AllowGateways(allow v1alpha1.GatewayAllowType) *TCPRouteBuilder {
+	b.r.Spec.Gateways.Allow = allow
+	return b
+}
+
+// Gateway allows the Gateway namespace/name to use the route, in addition
+// to the Gateways added before.
+func (b *TCPRouteBuilder) Gateway(namespace, name string) *TCPRouteBuilder {
+	addGateway(&b.r.Spec.Gateways, namespace, name)
+	return b
+}
+
+// Rule starts a new rule with the given matches. A rule without matches
+// matches all connections.
+func (b *TCPRouteBuilder) Rule(matches ...*TCPMatchBuilder) *TCPRouteBuilder {
+	rule := v1alpha1.TCPRouteRule{}
+	for _, m := range matches {
+		rule.Matches = append(rule.Matches, m.Build())
+	}
+	b.r.Spec.Rules = append(b.r.Spec.Rules, rule)
+	return b
+}
+
+// ForwardTo adds backends to the last rule, starting a rule that matches
+// all connections if the route has none.
+func (b *TCPRouteBuilder) ForwardTo(backends ...*ForwardToBuilder) *TCPRouteBuilder {
+	if len(b.r.Spec.Rules) == 0 {
+		b.Rule()
+	}
+	rule := &b.r.Spec.Rules[len(b.r.Spec.Rules)-1]
+	rule.ForwardTo = append(rule.ForwardTo, buildForwardTo(backends)...)
+	return b
+}
+
+// Status sets the status of the route.
+func (b *TCPRouteBuilder) Status(status *RouteStatusBuilder) *TCPRouteBuilder {
+	b.r.Status = v1alpha1.TCPRouteStatus{RouteStatus: status.Build()}
+	return b
+}
+
+// Build returns the route.
+func (b *TCPRouteBuilder) Build() *v1alpha1.TCPRoute {
+	return b.r.DeepCopy()
+}
+
+// TCPMatchBuilder builds a match of a TCPRoute rule.
+type TCPMatchBuilder struct {
+	m v1alpha1.TCPRouteMatch
+}
+
+// TCPMatch starts a match of all connections.
+func TCPMatch() *TCPMatchBuilder {
+	return &TCPMatchBuilder{}
+}
+
+// SourceCIDRs matches connections from one of the given CIDRs.
+func (b *TCPMatchBuilder) SourceCIDRs(cidrs ...string) *TCPMatchBuilder {
+	b.m.SourceCIDRs = append(b.m.SourceCIDRs, cidrs...)
+	return b
+}
+
+// Port matches connections to the given Gateway port.
+func (b *TCPMatchBuilder) Port(port int32) *TCPMatchBuilder {
+	b.m.Port = &port
+	return b
+}
+
+// Extension adds an implementation-specific match extension.
+func (b *TCPMatchBuilder) Extension(group, kind, name string) *TCPMatchBuilder {
+	b.m.ExtensionRef = &v1alpha1.LocalObjectReference{Group: group, Kind: kind, Name: name}
+	return b
+}
+
+// Build returns the match.
+func (b *TCPMatchBuilder) Build() v1alpha1.TCPRouteMatch {
+	return *b.m.DeepCopy()
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builders
+
+import (
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// TLSRouteBuilder builds a TLSRoute.
+type TLSRouteBuilder struct {
+	r v1alpha1.TLSRoute
+}
+
+// NewTLSRoute starts a TLSRoute without rules that only Gateways in its
+// own namespace may use.
+func NewTLSRoute(namespace, name string) *TLSRouteBuilder {
+	return &TLSRouteBuilder{r: v1alpha1.TLSRoute{
+		ObjectMeta: objectMeta(namespace, name),
+		Spec: v1alpha1.TLSRouteSpec{
+			Gateways: defaultRouteGateways(),
+			Rules:    []v1alpha1.TLSRouteRule{},
+		},
+	}}
+}
+
+// Labels adds labels to the route.
+func (b *TLSRouteBuilder) Labels(labels map[string]string) *TLSRouteBuilder {
+	addLabels(&b.r.ObjectMeta, labels)
+	return b
+}
+
+// Annotations adds annotations to the route.
+func (b *TLSRouteBuilder) Annotations(annotations map[string]string) *TLSRouteBuilder {
+	addAnnotations(&b.r.ObjectMeta, annotations)
+	return b
+}
+
+// AllowGateways sets which Gateways may use the route.
+func (b *TLSRouteBuilder) AllowGateways(allow v1alpha1.GatewayAllowType) *TLSRouteBuilder {
+	b.r.Spec.Gateways.Allow = allow
+	return b
+}
+
+// Gateway allows the Gateway namespace/name to use the route, in addition
+// to the Gateways added before.
+func (b *TLSRouteBuilder) Gateway(namespace, name string) *TLSRouteBuilder {
+	addGateway(&b.r.Spec.Gateways, namespace, name)
+	return b
+}
+
+// Rule starts a new rule with the given matches. A rule without matches
+// matches all connections.
+func (b *TLSRouteBuilder) Rule(matches ...*TLSMatchBuilder) *TLSRouteBuilder {
+	rule := v1alpha1.TLSRouteRule{}
+	for _, m := range matches {
+		rule.Matches = append(rule.Matches, m.Build())
+	}
+	b.r.Spec.Rules = append(b.r.Spec.Rules, rule)
+	return b
+}
+
+// ForwardTo adds backends to the last rule, starting a rule that matches
+// all connections if the route has none.
+func (b *TLSRouteBuilder) ForwardTo(backends ...*ForwardToBuilder) *TLSRouteBuilder {
+	if len(b.r.Spec.Rules) == 0 {
+		b.Rule()
+	}
+	rule := &b.r.Spec.Rules[len(b.r.Spec.Rules)-1]
+	rule.ForwardTo = append(rule.ForwardTo, buildForwardTo(backends)...)
+	return b
+}
+
+// Status sets the status of the route.
+func (b *TLSRouteBuilder) Status(status *RouteStatusBuilder) *TLSRouteBuilder {
+	b.r.Status = v1alpha1.TLSRouteStatus{RouteStatus: status.Build()}
+	return b
+}
+
+// Build returns the route.
+func (b *TLSRouteBuilder) Build() *v1alpha1.TLSRoute {
+	return b.r.DeepCopy()
+}
+
+// TLSMatchBuilder builds a match of a TLSRoute rule.
+type TLSMatchBuilder struct {
+	m v1alpha1.TLSRouteMatch
+}
+
+// TLSMatch starts a match of all connections.
+func TLSMatch() *TLSMatchBuilder {
+	return &TLSMatchBuilder{}
+}
+
+// SNIs matches connections whose server name is one of snis.
+func (b *TLSMatchBuilder) SNIs(snis ...string) *TLSMatchBuilder {
+	b.m.SNIs = append(b.m.SNIs, snis...)
+	return b
+}
+
+// ALPNProtocols matches connections that offer one of the protocols.
+func (b *TLSMatchBuilder) ALPNProtocols(protocols ...string) *TLSMatchBuilder {
+	b.m.ALPNProtocols = append(b.m.ALPNProtocols, protocols...)
+	return b
+}
+
+// Port matches connections to the given Gateway port.
+func (b *TLSMatchBuilder) Port(port int32) *TLSMatchBuilder {
+	b.m.Port = &port
+	return b
+}
+
+// Extension adds an implementation-specific match extension.
+func (b *TLSMatchBuilder) Extension(group, kind, name string) *TLSMatchBuilder {
+	b.m.ExtensionRef = &v1alpha1.LocalObjectReference{Group: group, Kind: kind, Name: name}
+	return b
+}
+
+// Build returns the match.
+func (b *TLSMatchBuilder) Build() v1alpha1.TLSRouteMatch {
+	return *b.m.DeepCopy()
+}
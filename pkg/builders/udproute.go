@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builders
+
+import (
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// UDPRouteBuilder builds a UDPRoute.
+type UDPRouteBuilder struct {
+	r v1alpha1.UDPRoute
+}
+
+// NewUDPRoute starts a UDPRoute without rules that only Gateways in its
+// own namespace may use.
+func NewUDPRoute(namespace, name string) *UDPRouteBuilder {
+	return &UDPRouteBuilder{r: v1alpha1.UDPRoute{
+		ObjectMeta: objectMeta(namespace, name),
+		Spec: v1alpha1.UDPRouteSpec{
+			Gateways: defaultRouteGateways(),
+			Rules:    []v1alpha1.UDPRouteRule{},
+		},
+	}}
+}
+
+// Labels adds labels to the route.
+func (b *UDPRouteBuilder) Labels(labels map[string]string) *UDPRouteBuilder {
+	addLabels(&b.r.ObjectMeta, labels)
+	return b
+}
+
+// Annotations adds annotations to the route.
+func (b *UDPRouteBuilder) Annotations(annotations map[string]string) *UDPRouteBuilder {
+	addAnnotations(&b.r.ObjectMeta, annotations)
+	return b
+}
+
+// AllowGateways sets which Gateways may use the route.
+func (b *UDPRouteBuilder) AllowGateways(allow v1alpha1.GatewayAllowType) *UDPRouteBuilder {
+	b.r.Spec.Gateways.Allow = allow
+	return b
+}
+
+// Gateway allows the Gateway namespace/name to use the route, in addition
+// to the Gateways added before.
+func (b *UDPRouteBuilder) Gateway(namespace, name string) *UDPRouteBuilder {
+	addGateway(&b.r.Spec.Gateways, namespace, name)
+	return b
+}
+
+// Rule starts a new rule with the given matches. A rule without matches
+// matches all connections.
+func (b *UDPRouteBuilder) Rule(matches ...*UDPMatchBuilder) *UDPRouteBuilder {
+	rule := v1alpha1.UDPRouteRule{}
+	for _, m := range matches {
+		rule.Matches = append(rule.Matches, m.Build())
+	}
+	b.r.Spec.Rules = append(b.r.Spec.Rules, rule)
+	return b
+}
+
+// ForwardTo adds backends to the last rule, starting a rule that matches
+// all connections if the route has none.
+func (b *UDPRouteBuilder) ForwardTo(backends ...*ForwardToBuilder) *UDPRouteBuilder {
+	if len(b.r.Spec.Rules) == 0 {
+		b.Rule()
+	}
+	rule := &b.r.Spec.Rules[len(b.r.Spec.Rules)-1]
+	rule.ForwardTo = append(rule.ForwardTo, buildForwardTo(backends)...)
+	return b
+}
+
+// Status sets the status of the route.
+func (b *UDPRouteBuilder) Status(status *RouteStatusBuilder) *UDPRouteBuilder {
+	b.r.Status = v1alpha1.UDPRouteStatus{RouteStatus: status.Build()}
+	return b
+}
+
+// Build returns the route.
+func (b *UDPRouteBuilder) Build() *v1alpha1.UDPRoute {
+	return b.r.DeepCopy()
+}
+
+// UDPMatchBuilder builds a match of a UDPRoute rule.
+type UDPMatchBuilder struct {
+	m v1alpha1.UDPRouteMatch
+}
+
+// UDPMatch starts a match of all connections.
+func UDPMatch() *UDPMatchBuilder {
+	return &UDPMatchBuilder{}
+}
+
+// SourceCIDRs matches connections from one of the given CIDRs.
+func (b *UDPMatchBuilder) SourceCIDRs(cidrs ...string) *UDPMatchBuilder {
+	b.m.SourceCIDRs = append(b.m.SourceCIDRs, cidrs...)
+	return b
+}
+
+// Port matches connections to the given Gateway port.
+func (b *UDPMatchBuilder) Port(port int32) *UDPMatchBuilder {
+	b.m.Port = &port
+	return b
+}
+
+// Extension adds an implementation-specific match extension.
+func (b *UDPMatchBuilder) Extension(group, kind, name string) *UDPMatchBuilder {
+	b.m.ExtensionRef = &v1alpha1.LocalObjectReference{Group: group, Kind: kind, Name: name}
+	return b
+}
+
+// Build returns the match.
+func (b *UDPMatchBuilder) Build() v1alpha1.UDPRouteMatch {
+	return *b.m.DeepCopy()
+}
@@ -0,0 +1,375 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/watch"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/testing"
+)
+
+// NewStrictClientset returns a clientset like NewSimpleClientset whose
+// tracker enforces more of the behavior of the API server, so that tests
+// of controllers fail the way the controllers would in a cluster:
+//
+//   - Update and Patch keep the stored status of the kinds with a status
+//     subresource, UpdateStatus and status patches change nothing but the
+//     status, and Create drops the status.
+//   - Create sets the generation of an object to 1, and every change to
+//     fields other than its metadata and status increments it.
+//   - Every write sets a new resourceVersion. Updates and patches that
+//     carry a resourceVersion other than the stored one fail with a
+//     Conflict error, and updates without a resourceVersion are invalid.
+//   - List and Watch filter by field selectors on metadata.name and
+//     metadata.namespace and reject selectors on other fields. Watch also
+//     filters by label selector, as List already does.
+//
+// Objects passed to NewStrictClientset are stored as they are, except
+// that a missing resourceVersion and generation are set.
+func NewStrictClientset(objects ...runtime.Object) *Clientset {
+	o := &strictTracker{ObjectTracker: testing.NewObjectTracker(scheme, codecs.UniversalDecoder())}
+	for _, obj := range objects {
+		if err := o.Add(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	cs := &Clientset{tracker: o}
+	cs.discovery = &fakediscovery.FakeDiscovery{Fake: &cs.Fake}
+	cs.AddReactor("*", "*", o.react)
+	cs.AddWatchReactor("*", o.watch)
+
+	return cs
+}
+
+// strictTracker wraps an ObjectTracker with the write semantics described
+// on NewStrictClientset. Writes through the reactors are serialized, so
+// that checking the resourceVersion and storing the object is atomic.
+type strictTracker struct {
+	testing.ObjectTracker
+
+	lock            sync.Mutex
+	resourceVersion int64
+}
+
+func (t *strictTracker) nextResourceVersion() string {
+	return strconv.FormatInt(atomic.AddInt64(&t.resourceVersion, 1), 10)
+}
+
+// Add stores obj, or the items of a list, setting a missing
+// resourceVersion and generation. Unlike the Add of the embedded tracker,
+// it stores Gateways under "gateways" rather than "gatewaies".
+func (t *strictTracker) Add(obj runtime.Object) error {
+	if meta.IsListType(obj) {
+		items, err := meta.ExtractList(obj)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := t.Add(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	obj = obj.DeepCopyObject()
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	if objMeta.GetResourceVersion() == "" {
+		objMeta.SetResourceVersion(t.nextResourceVersion())
+	}
+	if objMeta.GetGeneration() == 0 {
+		objMeta.SetGeneration(1)
+	}
+	gvks, _, err := scheme.ObjectKinds(obj)
+	if err != nil {
+		return err
+	}
+	return t.ObjectTracker.Create(kindToResource(gvks[0]), obj, objMeta.GetNamespace())
+}
+
+func (t *strictTracker) react(action testing.Action) (bool, runtime.Object, error) {
+	switch action := action.(type) {
+	case testing.ListActionImpl:
+		return t.list(action)
+	case testing.CreateActionImpl:
+		if action.GetSubresource() == "" {
+			return t.create(action)
+		}
+	case testing.UpdateActionImpl:
+		if sub := action.GetSubresource(); sub == "" || sub == "status" {
+			t.lock.Lock()
+			defer t.lock.Unlock()
+			return t.update(action.GetResource(), action.GetNamespace(), sub, action.GetObject())
+		}
+	case testing.PatchActionImpl:
+		if sub := action.GetSubresource(); sub == "" || sub == "status" {
+			return t.patch(action)
+		}
+	}
+	return testing.ObjectReaction(t)(action)
+}
+
+func (t *strictTracker) list(action testing.ListActionImpl) (bool, runtime.Object, error) {
+	selector := action.GetListRestrictions().Fields
+	if err := checkFieldSelector(selector); err != nil {
+		return true, nil, err
+	}
+
+	list, err := t.ObjectTracker.List(action.GetResource(), action.GetKind(), action.GetNamespace())
+	if err != nil {
+		return true, nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return true, nil, err
+	}
+	var matched []runtime.Object
+	for _, item := range items {
+		if matchesFields(item, selector) {
+			matched = append(matched, item)
+		}
+	}
+	if err := meta.SetList(list, matched); err != nil {
+		return true, nil, err
+	}
+	listMeta, err := meta.ListAccessor(list)
+	if err != nil {
+		return true, nil, err
+	}
+	listMeta.SetResourceVersion(strconv.FormatInt(atomic.LoadInt64(&t.resourceVersion), 10))
+	return true, list, nil
+}
+
+func (t *strictTracker) create(action testing.CreateActionImpl) (bool, runtime.Object, error) {
+	obj := action.GetObject().DeepCopyObject()
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return true, nil, err
+	}
+	if objMeta.GetResourceVersion() != "" {
+		return true, nil, errors.NewBadRequest("resourceVersion should not be set on objects to be created")
+	}
+	objMeta.SetGeneration(1)
+	objMeta.SetResourceVersion(t.nextResourceVersion())
+	if status := statusField(obj); status.IsValid() {
+		status.Set(reflect.Zero(status.Type()))
+	}
+
+	if err := t.ObjectTracker.Create(action.GetResource(), obj, action.GetNamespace()); err != nil {
+		return true, nil, err
+	}
+	obj, err = t.ObjectTracker.Get(action.GetResource(), action.GetNamespace(), objMeta.GetName())
+	return true, obj, err
+}
+
+// update stores obj as the new state of the object, or of its status if
+// subresource is "status". The caller must hold t.lock.
+func (t *strictTracker) update(gvr schema.GroupVersionResource, ns, subresource string, obj runtime.Object) (bool, runtime.Object, error) {
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return true, nil, err
+	}
+	name := objMeta.GetName()
+	old, err := t.ObjectTracker.Get(gvr, ns, name)
+	if err != nil {
+		return true, nil, err
+	}
+	oldMeta, err := meta.Accessor(old)
+	if err != nil {
+		return true, nil, err
+	}
+	if subresource == "status" && !statusField(old).IsValid() {
+		return true, nil, errors.NewNotFound(gvr.GroupResource(), name)
+	}
+
+	switch objMeta.GetResourceVersion() {
+	case oldMeta.GetResourceVersion():
+	case "":
+		gvks, _, err := scheme.ObjectKinds(obj)
+		if err != nil {
+			return true, nil, err
+		}
+		return true, nil, errors.NewInvalid(gvks[0].GroupKind(), name, field.ErrorList{
+			field.Invalid(field.NewPath("metadata", "resourceVersion"), "", "must be specified for an update"),
+		})
+	default:
+		return true, nil, errors.NewConflict(gvr.GroupResource(), name,
+			fmt.Errorf("the object has been modified; please apply your changes to the latest version and try again"))
+	}
+
+	var updated runtime.Object
+	if subresource == "status" {
+		updated = old.DeepCopyObject()
+		statusField(updated).Set(statusField(obj))
+	} else {
+		updated = obj.DeepCopyObject()
+		if status := statusField(updated); status.IsValid() {
+			status.Set(statusField(old))
+		}
+		generation := oldMeta.GetGeneration()
+		if specChanged(old, updated) {
+			generation++
+		}
+		updatedMeta, err := meta.Accessor(updated)
+		if err != nil {
+			return true, nil, err
+		}
+		updatedMeta.SetGeneration(generation)
+	}
+
+	updatedMeta, err := meta.Accessor(updated)
+	if err != nil {
+		return true, nil, err
+	}
+	updatedMeta.SetResourceVersion(t.nextResourceVersion())
+	if err := t.ObjectTracker.Update(gvr, updated, ns); err != nil {
+		return true, nil, err
+	}
+	updated, err = t.ObjectTracker.Get(gvr, ns, name)
+	return true, updated, err
+}
+
+// patch applies a patch with testing.ObjectReaction, and then stores the
+// result like an update. The patched object keeps the stored
+// resourceVersion, unless the patch sets one as a precondition.
+func (t *strictTracker) patch(action testing.PatchActionImpl) (bool, runtime.Object, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	capture := &captureTracker{ObjectTracker: t.ObjectTracker}
+	if _, _, err := testing.ObjectReaction(capture)(action); err != nil {
+		return true, nil, err
+	}
+	return t.update(action.GetResource(), action.GetNamespace(), action.GetSubresource(), capture.obj)
+}
+
+func (t *strictTracker) watch(action testing.Action) (bool, watch.Interface, error) {
+	restrictions := action.(testing.WatchAction).GetWatchRestrictions()
+	if err := checkFieldSelector(restrictions.Fields); err != nil {
+		return true, nil, err
+	}
+
+	w, err := t.ObjectTracker.Watch(action.GetResource(), action.GetNamespace())
+	if err != nil {
+		return false, nil, err
+	}
+	return true, watch.Filter(w, func(e watch.Event) (watch.Event, bool) {
+		objMeta, err := meta.Accessor(e.Object)
+		if err != nil {
+			return e, true
+		}
+		if restrictions.Labels != nil && !restrictions.Labels.Matches(labels.Set(objMeta.GetLabels())) {
+			return e, false
+		}
+		return e, matchesFields(e.Object, restrictions.Fields)
+	}), nil
+}
+
+// captureTracker records the object of an update instead of storing it.
+type captureTracker struct {
+	testing.ObjectTracker
+	obj runtime.Object
+}
+
+func (c *captureTracker) Update(gvr schema.GroupVersionResource, obj runtime.Object, ns string) error {
+	c.obj = obj
+	return nil
+}
+
+// kindToResource returns the resource of gvk. meta.UnsafeGuessKindToResource
+// turns every kind ending in "y" into "ies", including Gateway.
+func kindToResource(gvk schema.GroupVersionKind) schema.GroupVersionResource {
+	kind := strings.ToLower(gvk.Kind)
+	if n := len(kind); n > 1 && kind[n-1] == 'y' && strings.ContainsRune("aeiou", rune(kind[n-2])) {
+		return gvk.GroupVersion().WithResource(kind + "s")
+	}
+	gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+	return gvr
+}
+
+// statusField returns the Status field of obj, or an invalid value if the
+// kind of obj has no status subresource.
+func statusField(obj runtime.Object) reflect.Value {
+	v := reflect.ValueOf(obj).Elem()
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v.FieldByName("Status")
+}
+
+// specChanged reports whether old and updated differ in other fields than
+// their metadata and status.
+func specChanged(old, updated runtime.Object) bool {
+	o, u := reflect.ValueOf(old).Elem(), reflect.ValueOf(updated).Elem()
+	for i := 0; i < o.NumField(); i++ {
+		switch o.Type().Field(i).Name {
+		case "TypeMeta", "ObjectMeta", "Status":
+			continue
+		}
+		if !equality.Semantic.DeepEqual(o.Field(i).Interface(), u.Field(i).Interface()) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkFieldSelector rejects selectors on fields that the API server does
+// not support for custom resources.
+func checkFieldSelector(selector fields.Selector) error {
+	if selector == nil {
+		return nil
+	}
+	for _, r := range selector.Requirements() {
+		if r.Field != "metadata.name" && r.Field != "metadata.namespace" {
+			return errors.NewBadRequest(fmt.Sprintf("field label not supported: %s", r.Field))
+		}
+	}
+	return nil
+}
+
+func matchesFields(obj runtime.Object, selector fields.Selector) bool {
+	if selector == nil || selector.Empty() {
+		return true
+	}
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(fields.Set{
+		"metadata.name":      objMeta.GetName(),
+		"metadata.namespace": objMeta.GetNamespace(),
+	})
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/builders"
+	apisv1alpha1 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha1"
+)
+
+var admitted = builders.Condition(string(v1alpha1.ConditionRouteAdmitted), metav1.ConditionTrue, "Admitted")
+
+func TestStrictStatusSubresource(t *testing.T) {
+	ctx := context.Background()
+	routes := NewStrictClientset().NetworkingV1alpha1().HTTPRoutes("web")
+
+	route, err := routes.Create(ctx, builders.NewHTTPRoute("web", "route").
+		ForwardTo(builders.Service("svc", 80)).
+		Status(builders.RouteStatus().Gateway("infra", "gw", admitted)).
+		Build(), metav1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(route.Status.Gateways) != 0 {
+		t.Errorf("Create stored status %+v", route.Status)
+	}
+	if route.Generation != 1 {
+		t.Errorf("Create set generation %d, want 1", route.Generation)
+	}
+
+	route.Status.RouteStatus = builders.RouteStatus().Gateway("infra", "gw", admitted).Build()
+	route.Spec.Hostnames = []v1alpha1.HTTPRouteHostname{"example.com"}
+	route, err = routes.UpdateStatus(ctx, route, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(route.Status.Gateways) != 1 {
+		t.Errorf("UpdateStatus did not store status %+v", route.Status)
+	}
+	if len(route.Spec.Hostnames) != 0 || route.Generation != 1 {
+		t.Errorf("UpdateStatus changed spec to %+v, generation to %d", route.Spec, route.Generation)
+	}
+
+	route.Status = v1alpha1.HTTPRouteStatus{}
+	route.Spec.Hostnames = []v1alpha1.HTTPRouteHostname{"example.com"}
+	route, err = routes.Update(ctx, route, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(route.Status.Gateways) != 1 {
+		t.Errorf("Update changed status to %+v", route.Status)
+	}
+	if len(route.Spec.Hostnames) != 1 || route.Generation != 2 {
+		t.Errorf("Update stored spec %+v, generation %d, want generation 2", route.Spec, route.Generation)
+	}
+
+	route.Labels = map[string]string{"app": "web"}
+	route, err = routes.Update(ctx, route, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if route.Generation != 2 {
+		t.Errorf("updating labels set generation %d, want 2", route.Generation)
+	}
+}
+
+func TestStrictResourceVersion(t *testing.T) {
+	ctx := context.Background()
+	gw := builders.NewGateway("infra", "gw", "acme").Build()
+	gateways := NewStrictClientset(gw).NetworkingV1alpha1().Gateways("infra")
+
+	stale, err := gateways.Get(ctx, "gw", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stale.ResourceVersion == "" {
+		t.Fatal("seeded Gateway has no resourceVersion")
+	}
+
+	fresh := stale.DeepCopy()
+	fresh.Spec.GatewayClassName = "other"
+	fresh, err = gateways.Update(ctx, fresh, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fresh.ResourceVersion == stale.ResourceVersion {
+		t.Errorf("Update kept resourceVersion %s", fresh.ResourceVersion)
+	}
+
+	stale.Spec.GatewayClassName = "stale"
+	if _, err := gateways.Update(ctx, stale, metav1.UpdateOptions{}); !errors.IsConflict(err) {
+		t.Errorf("Update with a stale resourceVersion returned %v, want a conflict", err)
+	}
+	stale.Status = builders.GatewayStatus().Address(v1alpha1.IPAddressType, "10.0.0.1").Build()
+	if _, err := gateways.UpdateStatus(ctx, stale, metav1.UpdateOptions{}); !errors.IsConflict(err) {
+		t.Errorf("UpdateStatus with a stale resourceVersion returned %v, want a conflict", err)
+	}
+
+	fresh.ResourceVersion = ""
+	if _, err := gateways.Update(ctx, fresh, metav1.UpdateOptions{}); !errors.IsInvalid(err) {
+		t.Errorf("Update without resourceVersion returned %v, want an invalid error", err)
+	}
+}
+
+func TestStrictPatch(t *testing.T) {
+	ctx := context.Background()
+	routes := NewStrictClientset(builders.NewHTTPRoute("web", "route").Build()).NetworkingV1alpha1().HTTPRoutes("web")
+
+	route, err := routes.Patch(ctx, "route", types.MergePatchType,
+		[]byte(`{"spec":{"hostnames":["example.com"]},"status":{"gateways":[{"gatewayRef":{"namespace":"infra","name":"gw"}}]}}`),
+		metav1.PatchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(route.Spec.Hostnames) != 1 || route.Generation != 2 || len(route.Status.Gateways) != 0 {
+		t.Errorf("Patch stored spec %+v, status %+v, generation %d", route.Spec, route.Status, route.Generation)
+	}
+
+	route, err = routes.ApplyStatus(ctx, apisv1alpha1.HTTPRoute("route", "web").
+		WithStatus(apisv1alpha1.HTTPRouteStatus().WithGateways(apisv1alpha1.RouteGatewayStatus().
+			WithGatewayRef(apisv1alpha1.GatewayReference().WithNamespace("infra").WithName("gw")))),
+		metav1.ApplyOptions{FieldManager: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(route.Status.Gateways) != 1 || route.Generation != 2 {
+		t.Errorf("ApplyStatus stored status %+v, generation %d", route.Status, route.Generation)
+	}
+
+	_, err = routes.Patch(ctx, "route", types.MergePatchType,
+		[]byte(`{"metadata":{"resourceVersion":"1"},"spec":{"hostnames":[]}}`), metav1.PatchOptions{})
+	if !errors.IsConflict(err) {
+		t.Errorf("Patch with a stale resourceVersion returned %v, want a conflict", err)
+	}
+}
+
+func TestStrictSelectors(t *testing.T) {
+	ctx := context.Background()
+	cs := NewStrictClientset(
+		builders.NewHTTPRoute("web", "a").Labels(map[string]string{"app": "web"}).Build(),
+		builders.NewHTTPRoute("web", "b").Build(),
+		builders.NewHTTPRoute("other", "a").Build(),
+	)
+
+	list, err := cs.NetworkingV1alpha1().HTTPRoutes("").List(ctx, metav1.ListOptions{FieldSelector: "metadata.name=a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("listing by name returned %d routes, want 2", len(list.Items))
+	}
+
+	list, err = cs.NetworkingV1alpha1().HTTPRoutes("").List(ctx, metav1.ListOptions{FieldSelector: "metadata.namespace=web,metadata.name!=a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "b" {
+		t.Errorf("listing by namespace and name returned %+v, want web/b", list.Items)
+	}
+
+	if _, err := cs.NetworkingV1alpha1().HTTPRoutes("").List(ctx, metav1.ListOptions{FieldSelector: "spec.hostnames=x"}); !errors.IsBadRequest(err) {
+		t.Errorf("listing by an unsupported field returned %v, want a bad request", err)
+	}
+
+	w, err := cs.NetworkingV1alpha1().HTTPRoutes("web").Watch(ctx, metav1.ListOptions{LabelSelector: "app=web"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	for _, name := range []string{"c", "d"} {
+		route := builders.NewHTTPRoute("web", name)
+		if name == "d" {
+			route.Labels(map[string]string{"app": "web"})
+		}
+		if _, err := cs.NetworkingV1alpha1().HTTPRoutes("web").Create(ctx, route.Build(), metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	select {
+	case e := <-w.ResultChan():
+		if route := e.Object.(*v1alpha1.HTTPRoute); e.Type != watch.Added || route.Name != "d" {
+			t.Errorf("watch returned %s %s, want the addition of d", e.Type, route.Name)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("watch returned no event")
+	}
+}
@@ -19,6 +19,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"net/http"
+
 	rest "k8s.io/client-go/rest"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
 	"sigs.k8s.io/service-apis/pkg/client/clientset/versioned/scheme"
@@ -27,9 +29,11 @@ import (
 type NetworkingV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	BackendPoliciesGetter
+	GRPCRoutesGetter
 	GatewaysGetter
 	GatewayClassesGetter
 	HTTPRoutesGetter
+	ReferenceGrantsGetter
 	TCPRoutesGetter
 	TLSRoutesGetter
 	UDPRoutesGetter
@@ -44,6 +48,10 @@ func (c *NetworkingV1alpha1Client) BackendPolicies(namespace string) BackendPoli
 	return newBackendPolicies(c, namespace)
 }
 
+func (c *NetworkingV1alpha1Client) GRPCRoutes(namespace string) GRPCRouteInterface {
+	return newGRPCRoutes(c, namespace)
+}
+
 func (c *NetworkingV1alpha1Client) Gateways(namespace string) GatewayInterface {
 	return newGateways(c, namespace)
 }
@@ -56,6 +64,10 @@ func (c *NetworkingV1alpha1Client) HTTPRoutes(namespace string) HTTPRouteInterfa
 	return newHTTPRoutes(c, namespace)
 }
 
+func (c *NetworkingV1alpha1Client) ReferenceGrants(namespace string) ReferenceGrantInterface {
+	return newReferenceGrants(c, namespace)
+}
+
 func (c *NetworkingV1alpha1Client) TCPRoutes(namespace string) TCPRouteInterface {
 	return newTCPRoutes(c, namespace)
 }
@@ -69,12 +81,28 @@ func (c *NetworkingV1alpha1Client) UDPRoutes(namespace string) UDPRouteInterface
 }
 
 // NewForConfig creates a new NetworkingV1alpha1Client for the given config.
+// NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
 func NewForConfig(c *rest.Config) (*NetworkingV1alpha1Client, error) {
 	config := *c
 	if err := setConfigDefaults(&config); err != nil {
 		return nil, err
 	}
-	client, err := rest.RESTClientFor(&config)
+	httpClient, err := rest.HTTPClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return NewForConfigAndClient(&config, httpClient)
+}
+
+// NewForConfigAndClient creates a new NetworkingV1alpha1Client for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+func NewForConfigAndClient(c *rest.Config, h *http.Client) (*NetworkingV1alpha1Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientForConfigAndClient(&config, h)
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +32,10 @@ func (c *FakeNetworkingV1alpha1) BackendPolicies(namespace string) v1alpha1.Back
 	return &FakeBackendPolicies{c, namespace}
 }
 
+func (c *FakeNetworkingV1alpha1) GRPCRoutes(namespace string) v1alpha1.GRPCRouteInterface {
+	return &FakeGRPCRoutes{c, namespace}
+}
+
 func (c *FakeNetworkingV1alpha1) Gateways(namespace string) v1alpha1.GatewayInterface {
 	return &FakeGateways{c, namespace}
 }
@@ -44,6 +48,10 @@ func (c *FakeNetworkingV1alpha1) HTTPRoutes(namespace string) v1alpha1.HTTPRoute
 	return &FakeHTTPRoutes{c, namespace}
 }
 
+func (c *FakeNetworkingV1alpha1) ReferenceGrants(namespace string) v1alpha1.ReferenceGrantInterface {
+	return &FakeReferenceGrants{c, namespace}
+}
+
 func (c *FakeNetworkingV1alpha1) TCPRoutes(namespace string) v1alpha1.TCPRouteInterface {
 	return &FakeTCPRoutes{c, namespace}
 }
@@ -20,6 +20,8 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -28,6 +30,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	testing "k8s.io/client-go/testing"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
+	apisv1alpha1 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha1"
 )
 
 // FakeBackendPolicies implements BackendPolicyInterface
@@ -117,7 +120,7 @@ func (c *FakeBackendPolicies) UpdateStatus(ctx context.Context, backendPolicy *v
 // Delete takes name of the backendPolicy and deletes it. Returns an error if one occurs.
 func (c *FakeBackendPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(backendpoliciesResource, c.ns, name), &v1alpha1.BackendPolicy{})
+		Invokes(testing.NewDeleteActionWithOptions(backendpoliciesResource, c.ns, name, opts), &v1alpha1.BackendPolicy{})
 
 	return err
 }
@@ -140,3 +143,48 @@ func (c *FakeBackendPolicies) Patch(ctx context.Context, name string, pt types.P
 	}
 	return obj.(*v1alpha1.BackendPolicy), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied backendPolicy.
+func (c *FakeBackendPolicies) Apply(ctx context.Context, backendPolicy *apisv1alpha1.BackendPolicyApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.BackendPolicy, err error) {
+	if backendPolicy == nil {
+		return nil, fmt.Errorf("backendPolicy provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(backendPolicy)
+	if err != nil {
+		return nil, err
+	}
+	name := backendPolicy.Name
+	if name == nil {
+		return nil, fmt.Errorf("backendPolicy.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(backendpoliciesResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.BackendPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BackendPolicy), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeBackendPolicies) ApplyStatus(ctx context.Context, backendPolicy *apisv1alpha1.BackendPolicyApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.BackendPolicy, err error) {
+	if backendPolicy == nil {
+		return nil, fmt.Errorf("backendPolicy provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(backendPolicy)
+	if err != nil {
+		return nil, err
+	}
+	name := backendPolicy.Name
+	if name == nil {
+		return nil, fmt.Errorf("backendPolicy.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(backendpoliciesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.BackendPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BackendPolicy), err
+}
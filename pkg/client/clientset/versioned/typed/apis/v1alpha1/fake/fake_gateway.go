@@ -20,6 +20,8 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -28,6 +30,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	testing "k8s.io/client-go/testing"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
+	apisv1alpha1 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha1"
 )
 
 // FakeGateways implements GatewayInterface
@@ -117,7 +120,7 @@ func (c *FakeGateways) UpdateStatus(ctx context.Context, gateway *v1alpha1.Gatew
 // Delete takes name of the gateway and deletes it. Returns an error if one occurs.
 func (c *FakeGateways) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(gatewaysResource, c.ns, name), &v1alpha1.Gateway{})
+		Invokes(testing.NewDeleteActionWithOptions(gatewaysResource, c.ns, name, opts), &v1alpha1.Gateway{})
 
 	return err
 }
@@ -140,3 +143,48 @@ func (c *FakeGateways) Patch(ctx context.Context, name string, pt types.PatchTyp
 	}
 	return obj.(*v1alpha1.Gateway), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied gateway.
+func (c *FakeGateways) Apply(ctx context.Context, gateway *apisv1alpha1.GatewayApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.Gateway, err error) {
+	if gateway == nil {
+		return nil, fmt.Errorf("gateway provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(gateway)
+	if err != nil {
+		return nil, err
+	}
+	name := gateway.Name
+	if name == nil {
+		return nil, fmt.Errorf("gateway.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(gatewaysResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.Gateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Gateway), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeGateways) ApplyStatus(ctx context.Context, gateway *apisv1alpha1.GatewayApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.Gateway, err error) {
+	if gateway == nil {
+		return nil, fmt.Errorf("gateway provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(gateway)
+	if err != nil {
+		return nil, err
+	}
+	name := gateway.Name
+	if name == nil {
+		return nil, fmt.Errorf("gateway.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(gatewaysResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.Gateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Gateway), err
+}
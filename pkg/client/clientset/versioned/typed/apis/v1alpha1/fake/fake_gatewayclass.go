@@ -20,6 +20,8 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -28,6 +30,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	testing "k8s.io/client-go/testing"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
+	apisv1alpha1 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha1"
 )
 
 // FakeGatewayClasses implements GatewayClassInterface
@@ -110,7 +113,7 @@ func (c *FakeGatewayClasses) UpdateStatus(ctx context.Context, gatewayClass *v1a
 // Delete takes name of the gatewayClass and deletes it. Returns an error if one occurs.
 func (c *FakeGatewayClasses) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(gatewayclassesResource, name), &v1alpha1.GatewayClass{})
+		Invokes(testing.NewRootDeleteActionWithOptions(gatewayclassesResource, name, opts), &v1alpha1.GatewayClass{})
 	return err
 }
 
@@ -131,3 +134,46 @@ func (c *FakeGatewayClasses) Patch(ctx context.Context, name string, pt types.Pa
 	}
 	return obj.(*v1alpha1.GatewayClass), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied gatewayClass.
+func (c *FakeGatewayClasses) Apply(ctx context.Context, gatewayClass *apisv1alpha1.GatewayClassApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.GatewayClass, err error) {
+	if gatewayClass == nil {
+		return nil, fmt.Errorf("gatewayClass provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(gatewayClass)
+	if err != nil {
+		return nil, err
+	}
+	name := gatewayClass.Name
+	if name == nil {
+		return nil, fmt.Errorf("gatewayClass.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(gatewayclassesResource, *name, types.ApplyPatchType, data), &v1alpha1.GatewayClass{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.GatewayClass), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeGatewayClasses) ApplyStatus(ctx context.Context, gatewayClass *apisv1alpha1.GatewayClassApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.GatewayClass, err error) {
+	if gatewayClass == nil {
+		return nil, fmt.Errorf("gatewayClass provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(gatewayClass)
+	if err != nil {
+		return nil, err
+	}
+	name := gatewayClass.Name
+	if name == nil {
+		return nil, fmt.Errorf("gatewayClass.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(gatewayclassesResource, *name, types.ApplyPatchType, data, "status"), &v1alpha1.GatewayClass{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.GatewayClass), err
+}
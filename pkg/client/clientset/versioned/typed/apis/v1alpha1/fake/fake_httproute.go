@@ -20,6 +20,8 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -28,6 +30,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	testing "k8s.io/client-go/testing"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
+	apisv1alpha1 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha1"
 )
 
 // FakeHTTPRoutes implements HTTPRouteInterface
@@ -117,7 +120,7 @@ func (c *FakeHTTPRoutes) UpdateStatus(ctx context.Context, hTTPRoute *v1alpha1.H
 // Delete takes name of the hTTPRoute and deletes it. Returns an error if one occurs.
 func (c *FakeHTTPRoutes) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(httproutesResource, c.ns, name), &v1alpha1.HTTPRoute{})
+		Invokes(testing.NewDeleteActionWithOptions(httproutesResource, c.ns, name, opts), &v1alpha1.HTTPRoute{})
 
 	return err
 }
@@ -140,3 +143,48 @@ func (c *FakeHTTPRoutes) Patch(ctx context.Context, name string, pt types.PatchT
 	}
 	return obj.(*v1alpha1.HTTPRoute), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied hTTPRoute.
+func (c *FakeHTTPRoutes) Apply(ctx context.Context, hTTPRoute *apisv1alpha1.HTTPRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.HTTPRoute, err error) {
+	if hTTPRoute == nil {
+		return nil, fmt.Errorf("hTTPRoute provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(hTTPRoute)
+	if err != nil {
+		return nil, err
+	}
+	name := hTTPRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("hTTPRoute.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(httproutesResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.HTTPRoute{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.HTTPRoute), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeHTTPRoutes) ApplyStatus(ctx context.Context, hTTPRoute *apisv1alpha1.HTTPRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.HTTPRoute, err error) {
+	if hTTPRoute == nil {
+		return nil, fmt.Errorf("hTTPRoute provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(hTTPRoute)
+	if err != nil {
+		return nil, err
+	}
+	name := hTTPRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("hTTPRoute.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(httproutesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.HTTPRoute{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.HTTPRoute), err
+}
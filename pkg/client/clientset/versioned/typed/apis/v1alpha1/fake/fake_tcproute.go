@@ -20,6 +20,8 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -28,6 +30,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	testing "k8s.io/client-go/testing"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
+	apisv1alpha1 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha1"
 )
 
 // FakeTCPRoutes implements TCPRouteInterface
@@ -117,7 +120,7 @@ func (c *FakeTCPRoutes) UpdateStatus(ctx context.Context, tCPRoute *v1alpha1.TCP
 // Delete takes name of the tCPRoute and deletes it. Returns an error if one occurs.
 func (c *FakeTCPRoutes) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(tcproutesResource, c.ns, name), &v1alpha1.TCPRoute{})
+		Invokes(testing.NewDeleteActionWithOptions(tcproutesResource, c.ns, name, opts), &v1alpha1.TCPRoute{})
 
 	return err
 }
@@ -140,3 +143,48 @@ func (c *FakeTCPRoutes) Patch(ctx context.Context, name string, pt types.PatchTy
 	}
 	return obj.(*v1alpha1.TCPRoute), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied tCPRoute.
+func (c *FakeTCPRoutes) Apply(ctx context.Context, tCPRoute *apisv1alpha1.TCPRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TCPRoute, err error) {
+	if tCPRoute == nil {
+		return nil, fmt.Errorf("tCPRoute provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(tCPRoute)
+	if err != nil {
+		return nil, err
+	}
+	name := tCPRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("tCPRoute.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(tcproutesResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.TCPRoute{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TCPRoute), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeTCPRoutes) ApplyStatus(ctx context.Context, tCPRoute *apisv1alpha1.TCPRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TCPRoute, err error) {
+	if tCPRoute == nil {
+		return nil, fmt.Errorf("tCPRoute provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(tCPRoute)
+	if err != nil {
+		return nil, err
+	}
+	name := tCPRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("tCPRoute.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(tcproutesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.TCPRoute{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TCPRoute), err
+}
@@ -20,6 +20,8 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -28,6 +30,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	testing "k8s.io/client-go/testing"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
+	apisv1alpha1 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha1"
 )
 
 // FakeTLSRoutes implements TLSRouteInterface
@@ -117,7 +120,7 @@ func (c *FakeTLSRoutes) UpdateStatus(ctx context.Context, tLSRoute *v1alpha1.TLS
 // Delete takes name of the tLSRoute and deletes it. Returns an error if one occurs.
 func (c *FakeTLSRoutes) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(tlsroutesResource, c.ns, name), &v1alpha1.TLSRoute{})
+		Invokes(testing.NewDeleteActionWithOptions(tlsroutesResource, c.ns, name, opts), &v1alpha1.TLSRoute{})
 
 	return err
 }
@@ -140,3 +143,48 @@ func (c *FakeTLSRoutes) Patch(ctx context.Context, name string, pt types.PatchTy
 	}
 	return obj.(*v1alpha1.TLSRoute), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied tLSRoute.
+func (c *FakeTLSRoutes) Apply(ctx context.Context, tLSRoute *apisv1alpha1.TLSRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TLSRoute, err error) {
+	if tLSRoute == nil {
+		return nil, fmt.Errorf("tLSRoute provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(tLSRoute)
+	if err != nil {
+		return nil, err
+	}
+	name := tLSRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("tLSRoute.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(tlsroutesResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.TLSRoute{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TLSRoute), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeTLSRoutes) ApplyStatus(ctx context.Context, tLSRoute *apisv1alpha1.TLSRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TLSRoute, err error) {
+	if tLSRoute == nil {
+		return nil, fmt.Errorf("tLSRoute provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(tLSRoute)
+	if err != nil {
+		return nil, err
+	}
+	name := tLSRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("tLSRoute.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(tlsroutesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.TLSRoute{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TLSRoute), err
+}
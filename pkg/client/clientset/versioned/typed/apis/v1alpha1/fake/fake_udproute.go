@@ -20,6 +20,8 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -28,6 +30,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	testing "k8s.io/client-go/testing"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
+	apisv1alpha1 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha1"
 )
 
 // FakeUDPRoutes implements UDPRouteInterface
@@ -117,7 +120,7 @@ func (c *FakeUDPRoutes) UpdateStatus(ctx context.Context, uDPRoute *v1alpha1.UDP
 // Delete takes name of the uDPRoute and deletes it. Returns an error if one occurs.
 func (c *FakeUDPRoutes) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(udproutesResource, c.ns, name), &v1alpha1.UDPRoute{})
+		Invokes(testing.NewDeleteActionWithOptions(udproutesResource, c.ns, name, opts), &v1alpha1.UDPRoute{})
 
 	return err
 }
@@ -140,3 +143,48 @@ func (c *FakeUDPRoutes) Patch(ctx context.Context, name string, pt types.PatchTy
 	}
 	return obj.(*v1alpha1.UDPRoute), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied uDPRoute.
+func (c *FakeUDPRoutes) Apply(ctx context.Context, uDPRoute *apisv1alpha1.UDPRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.UDPRoute, err error) {
+	if uDPRoute == nil {
+		return nil, fmt.Errorf("uDPRoute provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(uDPRoute)
+	if err != nil {
+		return nil, err
+	}
+	name := uDPRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("uDPRoute.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(udproutesResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.UDPRoute{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.UDPRoute), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeUDPRoutes) ApplyStatus(ctx context.Context, uDPRoute *apisv1alpha1.UDPRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.UDPRoute, err error) {
+	if uDPRoute == nil {
+		return nil, fmt.Errorf("uDPRoute provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(uDPRoute)
+	if err != nil {
+		return nil, err
+	}
+	name := uDPRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("uDPRoute.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(udproutesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.UDPRoute{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.UDPRoute), err
+}
@@ -20,6 +20,8 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,6 +29,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	rest "k8s.io/client-go/rest"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
+	apisv1alpha1 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha1"
 	scheme "sigs.k8s.io/service-apis/pkg/client/clientset/versioned/scheme"
 )
 
@@ -47,6 +50,8 @@ type GatewayInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.GatewayList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Gateway, err error)
+	Apply(ctx context.Context, gateway *apisv1alpha1.GatewayApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.Gateway, err error)
+	ApplyStatus(ctx context.Context, gateway *apisv1alpha1.GatewayApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.Gateway, err error)
 	GatewayExpansion
 }
 
@@ -193,3 +198,59 @@ func (c *gateways) Patch(ctx context.Context, name string, pt types.PatchType, d
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied gateway.
+func (c *gateways) Apply(ctx context.Context, gateway *apisv1alpha1.GatewayApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.Gateway, err error) {
+	if gateway == nil {
+		return nil, fmt.Errorf("gateway provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(gateway)
+	if err != nil {
+		return nil, err
+	}
+	name := gateway.Name
+	if name == nil {
+		return nil, fmt.Errorf("gateway.Name must be provided to Apply")
+	}
+	result = &v1alpha1.Gateway{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("gateways").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *gateways) ApplyStatus(ctx context.Context, gateway *apisv1alpha1.GatewayApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.Gateway, err error) {
+	if gateway == nil {
+		return nil, fmt.Errorf("gateway provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(gateway)
+	if err != nil {
+		return nil, err
+	}
+
+	name := gateway.Name
+	if name == nil {
+		return nil, fmt.Errorf("gateway.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.Gateway{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("gateways").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
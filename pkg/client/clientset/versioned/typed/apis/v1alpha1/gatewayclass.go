@@ -20,6 +20,8 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,6 +29,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	rest "k8s.io/client-go/rest"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
+	apisv1alpha1 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha1"
 	scheme "sigs.k8s.io/service-apis/pkg/client/clientset/versioned/scheme"
 )
 
@@ -47,6 +50,8 @@ type GatewayClassInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.GatewayClassList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.GatewayClass, err error)
+	Apply(ctx context.Context, gatewayClass *apisv1alpha1.GatewayClassApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.GatewayClass, err error)
+	ApplyStatus(ctx context.Context, gatewayClass *apisv1alpha1.GatewayClassApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.GatewayClass, err error)
 	GatewayClassExpansion
 }
 
@@ -182,3 +187,57 @@ func (c *gatewayClasses) Patch(ctx context.Context, name string, pt types.PatchT
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied gatewayClass.
+func (c *gatewayClasses) Apply(ctx context.Context, gatewayClass *apisv1alpha1.GatewayClassApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.GatewayClass, err error) {
+	if gatewayClass == nil {
+		return nil, fmt.Errorf("gatewayClass provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(gatewayClass)
+	if err != nil {
+		return nil, err
+	}
+	name := gatewayClass.Name
+	if name == nil {
+		return nil, fmt.Errorf("gatewayClass.Name must be provided to Apply")
+	}
+	result = &v1alpha1.GatewayClass{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("gatewayclasses").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *gatewayClasses) ApplyStatus(ctx context.Context, gatewayClass *apisv1alpha1.GatewayClassApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.GatewayClass, err error) {
+	if gatewayClass == nil {
+		return nil, fmt.Errorf("gatewayClass provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(gatewayClass)
+	if err != nil {
+		return nil, err
+	}
+
+	name := gatewayClass.Name
+	if name == nil {
+		return nil, fmt.Errorf("gatewayClass.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.GatewayClass{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("gatewayclasses").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
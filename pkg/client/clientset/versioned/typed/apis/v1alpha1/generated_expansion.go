@@ -20,12 +20,16 @@ package v1alpha1
 
 type BackendPolicyExpansion interface{}
 
+type GRPCRouteExpansion interface{}
+
 type GatewayExpansion interface{}
 
 type GatewayClassExpansion interface{}
 
 type HTTPRouteExpansion interface{}
 
+type ReferenceGrantExpansion interface{}
+
 type TCPRouteExpansion interface{}
 
 type TLSRouteExpansion interface{}
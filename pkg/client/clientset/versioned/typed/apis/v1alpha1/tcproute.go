@@ -20,6 +20,8 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,6 +29,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	rest "k8s.io/client-go/rest"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
+	apisv1alpha1 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha1"
 	scheme "sigs.k8s.io/service-apis/pkg/client/clientset/versioned/scheme"
 )
 
@@ -47,6 +50,8 @@ type TCPRouteInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.TCPRouteList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.TCPRoute, err error)
+	Apply(ctx context.Context, tCPRoute *apisv1alpha1.TCPRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TCPRoute, err error)
+	ApplyStatus(ctx context.Context, tCPRoute *apisv1alpha1.TCPRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TCPRoute, err error)
 	TCPRouteExpansion
 }
 
@@ -193,3 +198,59 @@ func (c *tCPRoutes) Patch(ctx context.Context, name string, pt types.PatchType,
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied tCPRoute.
+func (c *tCPRoutes) Apply(ctx context.Context, tCPRoute *apisv1alpha1.TCPRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TCPRoute, err error) {
+	if tCPRoute == nil {
+		return nil, fmt.Errorf("tCPRoute provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(tCPRoute)
+	if err != nil {
+		return nil, err
+	}
+	name := tCPRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("tCPRoute.Name must be provided to Apply")
+	}
+	result = &v1alpha1.TCPRoute{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("tcproutes").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *tCPRoutes) ApplyStatus(ctx context.Context, tCPRoute *apisv1alpha1.TCPRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TCPRoute, err error) {
+	if tCPRoute == nil {
+		return nil, fmt.Errorf("tCPRoute provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(tCPRoute)
+	if err != nil {
+		return nil, err
+	}
+
+	name := tCPRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("tCPRoute.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.TCPRoute{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("tcproutes").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -20,6 +20,8 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,6 +29,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	rest "k8s.io/client-go/rest"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
+	apisv1alpha1 "sigs.k8s.io/service-apis/pkg/client/applyconfiguration/apis/v1alpha1"
 	scheme "sigs.k8s.io/service-apis/pkg/client/clientset/versioned/scheme"
 )
 
@@ -47,6 +50,8 @@ type TLSRouteInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.TLSRouteList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.TLSRoute, err error)
+	Apply(ctx context.Context, tLSRoute *apisv1alpha1.TLSRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TLSRoute, err error)
+	ApplyStatus(ctx context.Context, tLSRoute *apisv1alpha1.TLSRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TLSRoute, err error)
 	TLSRouteExpansion
 }
 
@@ -193,3 +198,59 @@ func (c *tLSRoutes) Patch(ctx context.Context, name string, pt types.PatchType,
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied tLSRoute.
+func (c *tLSRoutes) Apply(ctx context.Context, tLSRoute *apisv1alpha1.TLSRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TLSRoute, err error) {
+	if tLSRoute == nil {
+		return nil, fmt.Errorf("tLSRoute provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(tLSRoute)
+	if err != nil {
+		return nil, err
+	}
+	name := tLSRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("tLSRoute.Name must be provided to Apply")
+	}
+	result = &v1alpha1.TLSRoute{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("tlsroutes").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *tLSRoutes) ApplyStatus(ctx context.Context, tLSRoute *apisv1alpha1.TLSRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TLSRoute, err error) {
+	if tLSRoute == nil {
+		return nil, fmt.Errorf("tLSRoute provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(tLSRoute)
+	if err != nil {
+		return nil, err
+	}
+
+	name := tLSRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("tLSRoute.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.TLSRoute{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("tlsroutes").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
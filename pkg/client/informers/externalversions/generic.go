@@ -24,6 +24,7 @@ import (
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
+	v1alpha2 "sigs.k8s.io/service-apis/apis/v1alpha2"
 )
 
 // GenericInformer is type of SharedIndexInformer which will locate and delegate to other
@@ -55,12 +56,16 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=networking.x-k8s.io, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("backendpolicies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().BackendPolicies().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("grpcroutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().GRPCRoutes().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("gateways"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().Gateways().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("gatewayclasses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().GatewayClasses().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("httproutes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().HTTPRoutes().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("referencegrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().ReferenceGrants().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("tcproutes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().TCPRoutes().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("tlsroutes"):
@@ -68,6 +73,26 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1alpha1.SchemeGroupVersion.WithResource("udproutes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().UDPRoutes().Informer()}, nil
 
+		// Group=networking.x-k8s.io, Version=v1alpha2
+	case v1alpha2.SchemeGroupVersion.WithResource("backendpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha2().BackendPolicies().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("grpcroutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha2().GRPCRoutes().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("gateways"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha2().Gateways().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("gatewayclasses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha2().GatewayClasses().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("httproutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha2().HTTPRoutes().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("referencegrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha2().ReferenceGrants().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("tcproutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha2().TCPRoutes().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("tlsroutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha2().TLSRoutes().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("udproutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha2().UDPRoutes().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -0,0 +1,56 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// BackendPolicyListerExpansion allows custom methods to be added to
+// BackendPolicyLister.
+type BackendPolicyListerExpansion interface {
+	// ByBackend lists the BackendPolicies that apply to the backend of
+	// the given group and kind. It needs the BackendIndex.
+	ByBackend(group, kind, namespace, name string) ([]*v1alpha1.BackendPolicy, error)
+	// ByBackendService lists the BackendPolicies that apply to the
+	// Service namespace/name. It needs the BackendIndex.
+	ByBackendService(namespace, name string) ([]*v1alpha1.BackendPolicy, error)
+}
+
+// BackendPolicyNamespaceListerExpansion allows custom methods to be added
+// to BackendPolicyNamespaceLister.
+type BackendPolicyNamespaceListerExpansion interface{}
+
+// ByBackend lists the BackendPolicies that apply to the backend of the
+// given group and kind.
+func (s *backendPolicyLister) ByBackend(group, kind, namespace, name string) ([]*v1alpha1.BackendPolicy, error) {
+	objs, err := s.indexer.ByIndex(BackendIndex, BackendKey(group, kind, namespace, name))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*v1alpha1.BackendPolicy, 0, len(objs))
+	for _, obj := range objs {
+		ret = append(ret, obj.(*v1alpha1.BackendPolicy))
+	}
+	return ret, nil
+}
+
+// ByBackendService lists the BackendPolicies that apply to the Service
+// namespace/name.
+func (s *backendPolicyLister) ByBackendService(namespace, name string) ([]*v1alpha1.BackendPolicy, error) {
+	return s.ByBackend("core", "Service", namespace, name)
+}
@@ -18,54 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
-// BackendPolicyListerExpansion allows custom methods to be added to
-// BackendPolicyLister.
-type BackendPolicyListerExpansion interface{}
-
-// BackendPolicyNamespaceListerExpansion allows custom methods to be added to
-// BackendPolicyNamespaceLister.
-type BackendPolicyNamespaceListerExpansion interface{}
-
-// GatewayListerExpansion allows custom methods to be added to
-// GatewayLister.
-type GatewayListerExpansion interface{}
-
-// GatewayNamespaceListerExpansion allows custom methods to be added to
-// GatewayNamespaceLister.
-type GatewayNamespaceListerExpansion interface{}
-
 // GatewayClassListerExpansion allows custom methods to be added to
 // GatewayClassLister.
 type GatewayClassListerExpansion interface{}
 
-// HTTPRouteListerExpansion allows custom methods to be added to
-// HTTPRouteLister.
-type HTTPRouteListerExpansion interface{}
-
-// HTTPRouteNamespaceListerExpansion allows custom methods to be added to
-// HTTPRouteNamespaceLister.
-type HTTPRouteNamespaceListerExpansion interface{}
-
-// TCPRouteListerExpansion allows custom methods to be added to
-// TCPRouteLister.
-type TCPRouteListerExpansion interface{}
-
-// TCPRouteNamespaceListerExpansion allows custom methods to be added to
-// TCPRouteNamespaceLister.
-type TCPRouteNamespaceListerExpansion interface{}
-
-// TLSRouteListerExpansion allows custom methods to be added to
-// TLSRouteLister.
-type TLSRouteListerExpansion interface{}
-
-// TLSRouteNamespaceListerExpansion allows custom methods to be added to
-// TLSRouteNamespaceLister.
-type TLSRouteNamespaceListerExpansion interface{}
-
-// UDPRouteListerExpansion allows custom methods to be added to
-// UDPRouteLister.
-type UDPRouteListerExpansion interface{}
+// ReferenceGrantListerExpansion allows custom methods to be added to
+// ReferenceGrantLister.
+type ReferenceGrantListerExpansion interface{}
 
-// UDPRouteNamespaceListerExpansion allows custom methods to be added to
-// UDPRouteNamespaceLister.
-type UDPRouteNamespaceListerExpansion interface{}
+// ReferenceGrantNamespaceListerExpansion allows custom methods to be added to
+// ReferenceGrantNamespaceLister.
+type ReferenceGrantNamespaceListerExpansion interface{}
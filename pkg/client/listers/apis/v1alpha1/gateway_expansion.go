@@ -0,0 +1,59 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// GatewayListerExpansion allows custom methods to be added to
+// GatewayLister.
+type GatewayListerExpansion interface {
+	// ByGatewayClass lists the Gateways of the named class. It needs the
+	// GatewayClassIndex.
+	ByGatewayClass(name string) ([]*v1alpha1.Gateway, error)
+	// ByCertificateSecret lists the Gateways whose listeners use the
+	// Secret namespace/name. It needs the CertificateSecretIndex.
+	ByCertificateSecret(namespace, name string) ([]*v1alpha1.Gateway, error)
+}
+
+// GatewayNamespaceListerExpansion allows custom methods to be added to
+// GatewayNamespaceLister.
+type GatewayNamespaceListerExpansion interface{}
+
+// ByGatewayClass lists the Gateways of the named class.
+func (s *gatewayLister) ByGatewayClass(name string) ([]*v1alpha1.Gateway, error) {
+	return s.byIndex(GatewayClassIndex, name)
+}
+
+// ByCertificateSecret lists the Gateways whose listeners use the Secret
+// namespace/name.
+func (s *gatewayLister) ByCertificateSecret(namespace, name string) ([]*v1alpha1.Gateway, error) {
+	return s.byIndex(CertificateSecretIndex, namespace+"/"+name)
+}
+
+func (s *gatewayLister) byIndex(index, key string) ([]*v1alpha1.Gateway, error) {
+	objs, err := s.indexer.ByIndex(index, key)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*v1alpha1.Gateway, 0, len(objs))
+	for _, obj := range objs {
+		ret = append(ret, obj.(*v1alpha1.Gateway))
+	}
+	return ret, nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// HTTPRouteListerExpansion allows custom methods to be added to
+// HTTPRouteLister.
+type HTTPRouteListerExpansion interface {
+	// ByBackendService lists the HTTPRoutes that forward to the
+	// Service namespace/name. It needs the ServiceIndex.
+	ByBackendService(namespace, name string) ([]*v1alpha1.HTTPRoute, error)
+	// ByGateway lists the HTTPRoutes that list the Gateway namespace/name in
+	// their gatewayRefs. It needs the GatewayIndex.
+	ByGateway(namespace, name string) ([]*v1alpha1.HTTPRoute, error)
+}
+
+// HTTPRouteNamespaceListerExpansion allows custom methods to be added to
+// HTTPRouteNamespaceLister.
+type HTTPRouteNamespaceListerExpansion interface{}
+
+// ByBackendService lists the HTTPRoutes that forward to the Service
+// namespace/name.
+func (s *hTTPRouteLister) ByBackendService(namespace, name string) ([]*v1alpha1.HTTPRoute, error) {
+	return s.byIndex(ServiceIndex, namespace+"/"+name)
+}
+
+// ByGateway lists the HTTPRoutes that list the Gateway namespace/name in their
+// gatewayRefs.
+func (s *hTTPRouteLister) ByGateway(namespace, name string) ([]*v1alpha1.HTTPRoute, error) {
+	return s.byIndex(GatewayIndex, namespace+"/"+name)
+}
+
+func (s *hTTPRouteLister) byIndex(index, key string) ([]*v1alpha1.HTTPRoute, error) {
+	objs, err := s.indexer.ByIndex(index, key)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*v1alpha1.HTTPRoute, 0, len(objs))
+	for _, obj := range objs {
+		ret = append(ret, obj.(*v1alpha1.HTTPRoute))
+	}
+	return ret, nil
+}
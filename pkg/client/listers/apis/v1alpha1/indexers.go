@@ -0,0 +1,257 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// Indexes that answer which objects are affected when an object they
+// reference changes. Register them on the informers with RouteIndexers,
+// GatewayIndexers and BackendPolicyIndexers before the informers start;
+// the lister methods that use an index fail if it is missing. Objects in
+// a namespace are found with the cache.NamespaceIndex that the informers
+// register by default.
+const (
+	// ServiceIndex indexes routes by the Services they forward or mirror
+	// requests to, keyed by namespace/name.
+	ServiceIndex = "service"
+
+	// GatewayIndex indexes routes by the Gateways in their
+	// spec.gateways.gatewayRefs, keyed by namespace/name. Routes that
+	// allow all Gateways, or those in their namespace, list no Gateways.
+	GatewayIndex = "gateway"
+
+	// GatewayClassIndex indexes Gateways by the name of their class.
+	GatewayClassIndex = "gatewayClass"
+
+	// CertificateSecretIndex indexes Gateways by the Secrets their
+	// listeners use as certificates or client CA certificates, keyed by
+	// namespace/name.
+	CertificateSecretIndex = "certificateSecret"
+
+	// BackendIndex indexes BackendPolicies by their backend refs, keyed by
+	// BackendKey.
+	BackendIndex = "backend"
+)
+
+// RouteIndexers returns the indexers of every route kind.
+func RouteIndexers() cache.Indexers {
+	return cache.Indexers{
+		ServiceIndex: RouteServiceIndexFunc,
+		GatewayIndex: RouteGatewayIndexFunc,
+	}
+}
+
+// GatewayIndexers returns the indexers of Gateways.
+func GatewayIndexers() cache.Indexers {
+	return cache.Indexers{
+		GatewayClassIndex:      GatewayClassIndexFunc,
+		CertificateSecretIndex: GatewayCertificateSecretIndexFunc,
+	}
+}
+
+// BackendPolicyIndexers returns the indexers of BackendPolicies.
+func BackendPolicyIndexers() cache.Indexers {
+	return cache.Indexers{
+		BackendIndex: BackendPolicyBackendIndexFunc,
+	}
+}
+
+// BackendKey returns the BackendIndex key of a backend. An empty group
+// and "core" both select the core API group, and an empty kind selects
+// Services.
+func BackendKey(group, kind, namespace, name string) string {
+	if group == "" {
+		group = "core"
+	}
+	if kind == "" {
+		kind = "Service"
+	}
+	return group + "/" + kind + "/" + namespace + "/" + name
+}
+
+// RouteServiceIndexFunc indexes routes of every kind by ServiceIndex.
+func RouteServiceIndexFunc(obj interface{}) ([]string, error) {
+	var keys keySet
+	switch route := obj.(type) {
+	case *v1alpha1.HTTPRoute:
+		for _, rule := range route.Spec.Rules {
+			for _, f := range rule.ForwardTo {
+				keys.addService(route.Namespace, f.Namespace, f.ServiceName, f.BackendRef)
+				keys.addMirrors(route.Namespace, f.Filters)
+			}
+			keys.addMirrors(route.Namespace, rule.Filters)
+		}
+	case *v1alpha1.GRPCRoute:
+		for _, rule := range route.Spec.Rules {
+			keys.addForwardTo(route.Namespace, rule.ForwardTo)
+		}
+	case *v1alpha1.TCPRoute:
+		for _, rule := range route.Spec.Rules {
+			keys.addForwardTo(route.Namespace, rule.ForwardTo)
+		}
+	case *v1alpha1.TLSRoute:
+		for _, rule := range route.Spec.Rules {
+			keys.addForwardTo(route.Namespace, rule.ForwardTo)
+		}
+	case *v1alpha1.UDPRoute:
+		for _, rule := range route.Spec.Rules {
+			keys.addForwardTo(route.Namespace, rule.ForwardTo)
+		}
+	default:
+		return nil, fmt.Errorf("%T is not a route", obj)
+	}
+	return keys.list, nil
+}
+
+// RouteGatewayIndexFunc indexes routes of every kind by GatewayIndex.
+func RouteGatewayIndexFunc(obj interface{}) ([]string, error) {
+	var gateways v1alpha1.RouteGateways
+	switch route := obj.(type) {
+	case *v1alpha1.HTTPRoute:
+		gateways = route.Spec.Gateways
+	case *v1alpha1.GRPCRoute:
+		gateways = route.Spec.Gateways
+	case *v1alpha1.TCPRoute:
+		gateways = route.Spec.Gateways
+	case *v1alpha1.TLSRoute:
+		gateways = route.Spec.Gateways
+	case *v1alpha1.UDPRoute:
+		gateways = route.Spec.Gateways
+	default:
+		return nil, fmt.Errorf("%T is not a route", obj)
+	}
+	if gateways.Allow != v1alpha1.GatewayAllowFromList {
+		return nil, nil
+	}
+	var keys keySet
+	for _, ref := range gateways.GatewayRefs {
+		keys.add(ref.Namespace + "/" + ref.Name)
+	}
+	return keys.list, nil
+}
+
+// GatewayClassIndexFunc indexes Gateways by GatewayClassIndex.
+func GatewayClassIndexFunc(obj interface{}) ([]string, error) {
+	gw, ok := obj.(*v1alpha1.Gateway)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a Gateway", obj)
+	}
+	return []string{gw.Spec.GatewayClassName}, nil
+}
+
+// GatewayCertificateSecretIndexFunc indexes Gateways by
+// CertificateSecretIndex. A certificate reference without group and kind
+// selects a Secret.
+func GatewayCertificateSecretIndexFunc(obj interface{}) ([]string, error) {
+	gw, ok := obj.(*v1alpha1.Gateway)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a Gateway", obj)
+	}
+	var keys keySet
+	for _, l := range gw.Spec.Listeners {
+		if l.TLS == nil {
+			continue
+		}
+		ref := l.TLS.CertificateRef
+		if ref.Name != "" && isSecretRef(ref.Group, ref.Kind) {
+			namespace := gw.Namespace
+			if ref.Namespace != nil {
+				namespace = *ref.Namespace
+			}
+			keys.add(namespace + "/" + ref.Name)
+		}
+		if cv := l.TLS.ClientValidation; cv != nil && isSecretRef(cv.CACertificateRef.Group, cv.CACertificateRef.Kind) {
+			keys.add(gw.Namespace + "/" + cv.CACertificateRef.Name)
+		}
+	}
+	return keys.list, nil
+}
+
+// BackendPolicyBackendIndexFunc indexes BackendPolicies by BackendIndex.
+func BackendPolicyBackendIndexFunc(obj interface{}) ([]string, error) {
+	policy, ok := obj.(*v1alpha1.BackendPolicy)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a BackendPolicy", obj)
+	}
+	var keys keySet
+	for _, ref := range policy.Spec.BackendRefs {
+		keys.add(BackendKey(ref.Group, ref.Kind, policy.Namespace, ref.Name))
+	}
+	return keys.list, nil
+}
+
+// keySet collects index keys in order, without duplicates.
+type keySet struct {
+	seen map[string]bool
+	list []string
+}
+
+func (s *keySet) add(key string) {
+	if s.seen[key] {
+		return
+	}
+	if s.seen == nil {
+		s.seen = map[string]bool{}
+	}
+	s.seen[key] = true
+	s.list = append(s.list, key)
+}
+
+// addService adds the Service a route forwards to, if it references one.
+// ServiceName takes precedence over BackendRef, as specified by the API.
+func (s *keySet) addService(routeNamespace string, namespace, serviceName *string, ref *v1alpha1.LocalObjectReference) {
+	if namespace != nil {
+		routeNamespace = *namespace
+	}
+	switch {
+	case serviceName != nil:
+		s.add(routeNamespace + "/" + *serviceName)
+	case ref != nil && isServiceRef(ref.Group, ref.Kind):
+		s.add(routeNamespace + "/" + ref.Name)
+	}
+}
+
+func (s *keySet) addForwardTo(routeNamespace string, targets []v1alpha1.RouteForwardTo) {
+	for _, f := range targets {
+		s.addService(routeNamespace, f.Namespace, f.ServiceName, f.BackendRef)
+	}
+}
+
+func (s *keySet) addMirrors(routeNamespace string, filters []v1alpha1.HTTPRouteFilter) {
+	for _, f := range filters {
+		if m := f.RequestMirror; m != nil {
+			s.addService(routeNamespace, nil, m.ServiceName, m.BackendRef)
+		}
+	}
+}
+
+// isServiceRef reports whether a group and kind identify a core Service.
+func isServiceRef(group, kind string) bool {
+	return (group == "" || group == "core") && kind == "Service"
+}
+
+// isSecretRef reports whether a group and kind identify a core Secret.
+// A reference without group and kind selects a Secret too.
+func isSecretRef(group, kind string) bool {
+	return (group == "" && kind == "") || ((group == "" || group == "core") && kind == "Secret")
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"sort"
+	"testing"
+
+	"k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/builders"
+)
+
+func names(objs interface{}) []string {
+	var names []string
+	switch objs := objs.(type) {
+	case []*v1alpha1.HTTPRoute:
+		for _, o := range objs {
+			names = append(names, o.Name)
+		}
+	case []*v1alpha1.TCPRoute:
+		for _, o := range objs {
+			names = append(names, o.Name)
+		}
+	case []*v1alpha1.Gateway:
+		for _, o := range objs {
+			names = append(names, o.Name)
+		}
+	case []*v1alpha1.BackendPolicy:
+		for _, o := range objs {
+			names = append(names, o.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func newIndexer(t *testing.T, indexers cache.Indexers, objs ...interface{}) cache.Indexer {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, indexers)
+	for _, obj := range objs {
+		if err := indexer.Add(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return indexer
+}
+
+func TestRouteIndexers(t *testing.T) {
+	routes := NewHTTPRouteLister(newIndexer(t, RouteIndexers(),
+		builders.NewHTTPRoute("web", "service-name").ForwardTo(builders.Service("svc", 80)).Build(),
+		builders.NewHTTPRoute("web", "backend-ref").ForwardTo(builders.Backend("core", "Service", "svc")).Build(),
+		builders.NewHTTPRoute("other", "cross-namespace").ForwardTo(builders.Service("svc", 80).Namespace("web")).Build(),
+		builders.NewHTTPRoute("web", "mirror").Filter(builders.Mirror("svc", 80)).ForwardTo(builders.Service("main", 80)).Build(),
+		builders.NewHTTPRoute("web", "custom-backend").ForwardTo(builders.Backend("example.com", "Bucket", "svc")).Build(),
+		builders.NewHTTPRoute("other", "same-name").ForwardTo(builders.Service("svc", 80)).Build(),
+		builders.NewHTTPRoute("web", "gateway").Gateway("infra", "gw").Build(),
+	))
+
+	got, err := routes.ByBackendService("web", "svc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"backend-ref", "cross-namespace", "mirror", "service-name"}; !equal(names(got), want) {
+		t.Errorf("ByBackendService = %v, want %v", names(got), want)
+	}
+
+	got, err = routes.ByGateway("infra", "gw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"gateway"}; !equal(names(got), want) {
+		t.Errorf("ByGateway = %v, want %v", names(got), want)
+	}
+
+	tcpRoutes := NewTCPRouteLister(newIndexer(t, RouteIndexers(),
+		builders.NewTCPRoute("db", "postgres").Gateway("infra", "gw").ForwardTo(builders.Service("postgres", 5432)).Build(),
+	))
+	tcp, err := tcpRoutes.ByBackendService("db", "postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"postgres"}; !equal(names(tcp), want) {
+		t.Errorf("TCPRoute ByBackendService = %v, want %v", names(tcp), want)
+	}
+}
+
+func TestGatewayIndexers(t *testing.T) {
+	gateways := NewGatewayLister(newIndexer(t, GatewayIndexers(),
+		builders.NewGateway("infra", "plain", "acme").Listener(builders.Listener(v1alpha1.HTTPProtocolType, 80)).Build(),
+		builders.NewGateway("infra", "tls", "acme").
+			Listener(builders.Listener(v1alpha1.HTTPSProtocolType, 443).TLS(builders.TerminateTLS(builders.Secret("cert")))).
+			Build(),
+		builders.NewGateway("infra", "client-ca", "other").
+			Listener(builders.Listener(v1alpha1.HTTPSProtocolType, 443).
+				TLS(builders.TerminateTLS(builders.Secret("other-cert")).ClientValidation("", "Secret", "cert"))).
+			Build(),
+	))
+
+	got, err := gateways.ByGatewayClass("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"plain", "tls"}; !equal(names(got), want) {
+		t.Errorf("ByGatewayClass = %v, want %v", names(got), want)
+	}
+
+	got, err = gateways.ByCertificateSecret("infra", "cert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"client-ca", "tls"}; !equal(names(got), want) {
+		t.Errorf("ByCertificateSecret = %v, want %v", names(got), want)
+	}
+}
+
+func TestBackendPolicyIndexers(t *testing.T) {
+	policies := NewBackendPolicyLister(newIndexer(t, BackendPolicyIndexers(),
+		builders.NewBackendPolicy("web", "svc").Service("svc", 0).Build(),
+		builders.NewBackendPolicy("web", "default-kind").Backend("", "", "svc").Build(),
+		builders.NewBackendPolicy("web", "bucket").Backend("example.com", "Bucket", "svc").Build(),
+	))
+
+	got, err := policies.ByBackendService("web", "svc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"default-kind", "svc"}; !equal(names(got), want) {
+		t.Errorf("ByBackendService = %v, want %v", names(got), want)
+	}
+
+	got, err = policies.ByBackend("example.com", "Bucket", "web", "svc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"bucket"}; !equal(names(got), want) {
+		t.Errorf("ByBackend = %v, want %v", names(got), want)
+	}
+}
+
+func TestMissingIndex(t *testing.T) {
+	routes := NewHTTPRouteLister(newIndexer(t, cache.Indexers{}))
+	if _, err := routes.ByBackendService("web", "svc"); err == nil {
+		t.Error("ByBackendService succeeded without the ServiceIndex")
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// dotShapes are the Graphviz node shapes used for each kind of node.
+var dotShapes = map[NodeKind]string{
+	NodeGatewayClass:  "doubleoctagon",
+	NodeGateway:       "octagon",
+	NodeListener:      "cds",
+	NodeRoute:         "box",
+	NodeRule:          "ellipse",
+	NodeBackend:       "cylinder",
+	NodeBackendPolicy: "note",
+	NodeSecret:        "component",
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language.
+func WriteDOT(w io.Writer, g *Graph) error {
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "digraph gateways {\n")
+	fmt.Fprintf(b, "  rankdir=LR;\n")
+	for _, n := range g.Nodes {
+		fmt.Fprintf(b, "  %q [label=%q, shape=%s];\n", n.ID, n.Label, dotShapes[n.Kind])
+	}
+	for _, e := range g.Edges {
+		if e.Label == "" {
+			fmt.Fprintf(b, "  %q -> %q;\n", e.From, e.To)
+		} else {
+			fmt.Fprintf(b, "  %q -> %q [label=%q];\n", e.From, e.To, e.Label)
+		}
+	}
+	fmt.Fprintf(b, "}\n")
+	return b.Flush()
+}
+
+// WriteMermaid writes the graph as a Mermaid flowchart, which can be
+// embedded in Markdown documents.
+func WriteMermaid(w io.Writer, g *Graph) error {
+	// Mermaid node IDs cannot contain most punctuation, so nodes are
+	// numbered in order.
+	ids := map[string]string{}
+	for i, n := range g.Nodes {
+		ids[n.ID] = fmt.Sprintf("n%d", i)
+	}
+
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "graph LR\n")
+	for _, n := range g.Nodes {
+		fmt.Fprintf(b, "  %s[\"%s\"]\n", ids[n.ID], mermaidText(n.Label))
+	}
+	for _, e := range g.Edges {
+		if e.Label == "" {
+			fmt.Fprintf(b, "  %s --> %s\n", ids[e.From], ids[e.To])
+		} else {
+			fmt.Fprintf(b, "  %s -->|\"%s\"| %s\n", ids[e.From], mermaidText(e.Label), ids[e.To])
+		}
+	}
+	return b.Flush()
+}
+
+// mermaidText escapes text for use in a quoted Mermaid label.
+func mermaidText(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	return strings.ReplaceAll(s, "\n", "<br/>")
+}
+
+// WriteJSON writes the graph as a JSON object with "nodes" and "edges"
+// arrays.
+func WriteJSON(w io.Writer, g *Graph) error {
+	out := *g
+	if out.Nodes == nil {
+		out.Nodes = []Node{}
+	}
+	if out.Edges == nil {
+		out.Edges = []Edge{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
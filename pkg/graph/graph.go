@@ -0,0 +1,327 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package graph builds the object graph of a Gateway topology, from
+// GatewayClasses down to the backends that routes forward to, and writes
+// it as Graphviz DOT, Mermaid or JSON.
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/topology"
+)
+
+// NodeKind is the kind of object a node represents.
+type NodeKind string
+
+// NodeKind values.
+const (
+	NodeGatewayClass  NodeKind = "GatewayClass"
+	NodeGateway       NodeKind = "Gateway"
+	NodeListener      NodeKind = "Listener"
+	NodeRoute         NodeKind = "Route"
+	NodeRule          NodeKind = "Rule"
+	NodeBackend       NodeKind = "Backend"
+	NodeBackendPolicy NodeKind = "BackendPolicy"
+	NodeSecret        NodeKind = "Secret"
+)
+
+// Node is an object, or part of an object, in the graph.
+type Node struct {
+	// ID uniquely identifies the node in the graph.
+	ID string `json:"id"`
+
+	Kind NodeKind `json:"kind"`
+
+	// Namespace and Name identify the object the node represents. Name
+	// is the name of the parent object for listeners and rules.
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+
+	// Label is a short human-readable description of the node.
+	Label string `json:"label"`
+}
+
+// Edge connects a node to a node that it refers to or contains.
+type Edge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Label string `json:"label,omitempty"`
+}
+
+// Graph is the object graph of a Gateway topology. Nodes and edges are
+// ordered deterministically, so that the output of a snapshot is stable.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+
+	// index holds the IDs of the nodes and edges in the graph.
+	index map[string]bool
+}
+
+func (g *Graph) addNode(n Node) {
+	if g.index[n.ID] {
+		return
+	}
+	g.index[n.ID] = true
+	g.Nodes = append(g.Nodes, n)
+}
+
+func (g *Graph) addEdge(from, to, label string) {
+	id := from + " -> " + to + " " + label
+	if g.index[id] {
+		return
+	}
+	g.index[id] = true
+	g.Edges = append(g.Edges, Edge{From: from, To: to, Label: label})
+}
+
+func (g *Graph) hasNode(id string) bool {
+	return g.index[id]
+}
+
+// Build returns the graph of the Gateways in the snapshot: their
+// GatewayClasses and Listeners, the routes bound to each Listener, the
+// rules of those routes and their backends, the BackendPolicies that
+// apply to the backends, and the certificates referenced along the way.
+// Routes that are not bound to any Listener are omitted.
+func Build(res *topology.Resources) *Graph {
+	g := &Graph{index: map[string]bool{}}
+
+	gateways := make([]*v1alpha1.Gateway, 0, len(res.Gateways))
+	for i := range res.Gateways {
+		gateways = append(gateways, &res.Gateways[i])
+	}
+	sort.Slice(gateways, func(i, j int) bool {
+		if gateways[i].Namespace != gateways[j].Namespace {
+			return gateways[i].Namespace < gateways[j].Namespace
+		}
+		return gateways[i].Name < gateways[j].Name
+	})
+
+	for _, gw := range gateways {
+		gwID := objectID(string(NodeGateway), gw.Namespace, gw.Name)
+		g.addNode(Node{ID: gwID, Kind: NodeGateway, Namespace: gw.Namespace, Name: gw.Name,
+			Label: fmt.Sprintf("Gateway %s/%s", gw.Namespace, gw.Name)})
+
+		if gc := res.GatewayClass(gw.Spec.GatewayClassName); gc != nil {
+			gcID := objectID(string(NodeGatewayClass), "", gc.Name)
+			g.addNode(Node{ID: gcID, Kind: NodeGatewayClass, Name: gc.Name,
+				Label: fmt.Sprintf("GatewayClass %s\n%s", gc.Name, gc.Spec.Controller)})
+			g.addEdge(gcID, gwID, "")
+		}
+
+		for _, lb := range res.Bind(gw).Listeners {
+			listenerID := fmt.Sprintf("%s/%d", objectID(string(NodeListener), gw.Namespace, gw.Name), lb.Index)
+			g.addNode(Node{ID: listenerID, Kind: NodeListener, Namespace: gw.Namespace, Name: gw.Name,
+				Label: describeListener(lb.Index, lb.Listener)})
+			g.addEdge(gwID, listenerID, "")
+
+			if tls := lb.Listener.TLS; tls != nil && tls.CertificateRef.Name != "" {
+				g.addReference(listenerID, certificateNamespace(gw.Namespace, tls.CertificateRef), "certificateRef",
+					tls.CertificateRef.LocalObjectReference)
+			}
+			if tls := lb.Listener.TLS; tls != nil && tls.ClientValidation != nil {
+				g.addReference(listenerID, gw.Namespace, "caCertificateRef", tls.ClientValidation.CACertificateRef)
+			}
+
+			for _, route := range lb.Routes {
+				g.addRoute(res, listenerID, route)
+			}
+		}
+	}
+
+	g.addBackendPolicies(res)
+
+	return g
+}
+
+// addRoute adds a route bound to a listener, with its rules and backends.
+// A route bound to several Listeners is added once.
+func (g *Graph) addRoute(res *topology.Resources, listenerID string, route *topology.Route) {
+	routeID := objectID(route.Kind, route.Namespace, route.Name)
+	if g.hasNode(routeID) {
+		g.addEdge(listenerID, routeID, "")
+		return
+	}
+
+	label := fmt.Sprintf("%s %s/%s", route.Kind, route.Namespace, route.Name)
+	if len(route.Hostnames) > 0 {
+		label += "\n" + strings.Join(route.Hostnames, "\n")
+	}
+	g.addNode(Node{ID: routeID, Kind: NodeRoute, Namespace: route.Namespace, Name: route.Name, Label: label})
+	g.addEdge(listenerID, routeID, "")
+
+	if r, ok := route.Object.(*v1alpha1.HTTPRoute); ok && r.Spec.TLS != nil {
+		g.addReference(routeID, certificateNamespace(route.Namespace, r.Spec.TLS.CertificateRef), "certificateRef",
+			r.Spec.TLS.CertificateRef.LocalObjectReference)
+	}
+
+	for i, rule := range route.Rules {
+		ruleID := fmt.Sprintf("%s/%d", routeID, i)
+		g.addNode(Node{ID: ruleID, Kind: NodeRule, Namespace: route.Namespace, Name: route.Name,
+			Label: fmt.Sprintf("rule %d", i)})
+		g.addEdge(routeID, ruleID, "")
+
+		for _, b := range rule.ForwardTo {
+			backendID := g.addBackend(res, route.Namespace, b)
+			label := fmt.Sprintf("weight %d", weight(b.Weight))
+			if b.Port != nil {
+				label = fmt.Sprintf("port %d, %s", *b.Port, label)
+			}
+			if res.ResolveBackend(route, b).NotPermitted {
+				label += ", not permitted"
+			}
+			g.addEdge(ruleID, backendID, label)
+		}
+	}
+}
+
+// addBackend adds a forwarding target of a route in the given namespace
+// and returns the ID of its node. The namespace of the target takes
+// precedence, and ServiceName takes precedence over BackendRef, as
+// specified by the API. Services that are not part of the snapshot are
+// labelled as not found.
+func (g *Graph) addBackend(res *topology.Resources, namespace string, b topology.Backend) string {
+	if b.Namespace != nil {
+		namespace = *b.Namespace
+	}
+	group, kind, name := "", "Service", ""
+	switch {
+	case b.ServiceName != nil:
+		name = *b.ServiceName
+	case b.BackendRef != nil:
+		group, kind, name = b.BackendRef.Group, b.BackendRef.Kind, b.BackendRef.Name
+	}
+
+	id := backendID(namespace, group, kind, name)
+	label := fmt.Sprintf("%s %s/%s", qualifiedKind(group, kind), namespace, name)
+	if kind == "Service" && (group == "" || group == "core") && res.Service(namespace, name) == nil {
+		if res.Listed("Services") {
+			label += "\n(not found)"
+		} else {
+			label += "\n(unknown)"
+		}
+	}
+	g.addNode(Node{ID: id, Kind: NodeBackend, Namespace: namespace, Name: name, Label: label})
+	return id
+}
+
+// addBackendPolicies adds the BackendPolicies that apply to backends in
+// the graph.
+func (g *Graph) addBackendPolicies(res *topology.Resources) {
+	for i := range res.BackendPolicies {
+		policy := &res.BackendPolicies[i]
+		policyID := objectID(string(NodeBackendPolicy), policy.Namespace, policy.Name)
+
+		for _, ref := range policy.Spec.BackendRefs {
+			kind := ref.Kind
+			if kind == "" {
+				kind = "Service"
+			}
+			target := backendID(policy.Namespace, ref.Group, kind, ref.Name)
+			if !g.hasNode(target) {
+				continue
+			}
+
+			if !g.hasNode(policyID) {
+				g.addNode(Node{ID: policyID, Kind: NodeBackendPolicy, Namespace: policy.Namespace, Name: policy.Name,
+					Label: fmt.Sprintf("BackendPolicy %s/%s", policy.Namespace, policy.Name)})
+				if tls := policy.Spec.TLS; tls != nil {
+					if tls.ClientCertificateRef != nil {
+						g.addReference(policyID, policy.Namespace, "clientCertificateRef", *tls.ClientCertificateRef)
+					}
+					if tls.CertificateAuthorityRef != nil {
+						g.addReference(policyID, policy.Namespace, "certificateAuthorityRef", *tls.CertificateAuthorityRef)
+					}
+				}
+			}
+
+			label := ""
+			if ref.Port != nil {
+				label = fmt.Sprintf("port %d", *ref.Port)
+			}
+			g.addEdge(policyID, target, label)
+		}
+	}
+}
+
+// addReference adds an edge from a node to the certificate it refers to.
+// Omitting both the group and the kind selects a Secret.
+func (g *Graph) addReference(from, namespace, field string, ref v1alpha1.LocalObjectReference) {
+	group, kind := ref.Group, ref.Kind
+	if group == "" && kind == "" {
+		kind = "Secret"
+	}
+	if group == "core" {
+		group = ""
+	}
+
+	id := objectID(qualifiedKind(group, kind), namespace, ref.Name)
+	g.addNode(Node{ID: id, Kind: NodeSecret, Namespace: namespace, Name: ref.Name,
+		Label: fmt.Sprintf("%s %s/%s", qualifiedKind(group, kind), namespace, ref.Name)})
+	g.addEdge(from, id, field)
+}
+
+// certificateNamespace returns the namespace of a certificate referenced
+// from the given namespace.
+func certificateNamespace(namespace string, ref v1alpha1.CertificateObjectReference) string {
+	if ref.Namespace != nil {
+		return *ref.Namespace
+	}
+	return namespace
+}
+
+func objectID(kind, namespace, name string) string {
+	if namespace == "" {
+		return kind + "/" + name
+	}
+	return kind + "/" + namespace + "/" + name
+}
+
+func backendID(namespace, group, kind, name string) string {
+	if group == "core" {
+		group = ""
+	}
+	return objectID("Backend/"+qualifiedKind(group, kind), namespace, name)
+}
+
+func qualifiedKind(group, kind string) string {
+	if group == "" || group == "core" {
+		return kind
+	}
+	return kind + "." + group
+}
+
+func describeListener(index int, l v1alpha1.Listener) string {
+	label := fmt.Sprintf("listener %d\n%s :%d", index, l.Protocol, l.Port)
+	if l.Hostname.Name != "" {
+		label += "\n" + l.Hostname.Name
+	}
+	return label
+}
+
+// weight returns the effective weight of a backend. Weight defaults to 1.
+func weight(w int32) int32 {
+	if w == 0 {
+		return 1
+	}
+	return w
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/service-apis/pkg/manifest"
+	"sigs.k8s.io/service-apis/pkg/topology"
+)
+
+func TestBuild(t *testing.T) {
+	objects, err := manifest.Load("../../examples/tls-in-route.yaml", "../../examples/backendpolicy.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := &topology.Resources{}
+	for _, o := range objects {
+		res.Add(o.Object)
+	}
+
+	g := Build(res)
+
+	edges := map[string]bool{}
+	for _, e := range g.Edges {
+		edges[e.From+" -> "+e.To] = true
+	}
+	for _, want := range []string{
+		"GatewayClass/acme-lb -> Gateway/default/my-gateway",
+		"Gateway/default/my-gateway -> Listener/default/my-gateway/0",
+		"Listener/default/my-gateway/0 -> Secret/default/default-cert",
+		"Listener/default/my-gateway/0 -> HTTPRoute/default/http-app-1",
+		"HTTPRoute/default/http-app-1 -> HTTPRoute/default/http-app-1/0",
+		"HTTPRoute/default/http-app-1/0 -> Backend/Service/default/my-service",
+		"BackendPolicy/default/my-app -> Backend/Service/default/my-service",
+		"BackendPolicy/default/my-app -> Secret/default/my-app-ca",
+	} {
+		if !edges[want] {
+			t.Errorf("missing edge %q", want)
+		}
+	}
+
+	for _, e := range g.Edges {
+		if !g.hasNode(e.From) || !g.hasNode(e.To) {
+			t.Errorf("edge %s -> %s refers to a missing node", e.From, e.To)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, g); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Graph
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Nodes) != len(g.Nodes) || len(decoded.Edges) != len(g.Edges) {
+		t.Errorf("JSON round trip changed the graph size")
+	}
+}
@@ -0,0 +1,405 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/apis/v1alpha1/validation"
+	"sigs.k8s.io/service-apis/pkg/topology"
+)
+
+// routeKinds lists the route kinds defined in the networking.x-k8s.io
+// API group.
+var routeKinds = map[string]bool{
+	topology.KindGRPCRoute: true,
+	topology.KindHTTPRoute: true,
+	topology.KindTCPRoute:  true,
+	topology.KindTLSRoute:  true,
+	topology.KindUDPRoute:  true,
+}
+
+// checkListeners reports listeners that select route kinds that do not
+// exist, or that cannot serve the listener protocol.
+func (l *linter) checkListeners() {
+	for i := range l.res.Gateways {
+		gw := &l.res.Gateways[i]
+		for i, listener := range gw.Spec.Listeners {
+			group := listener.Routes.Group
+			if group != "" && group != v1alpha1.GroupName {
+				// Route kinds of other groups are implementation-specific.
+				continue
+			}
+
+			kind := listener.Routes.Kind
+			switch {
+			case !routeKinds[kind]:
+				l.report(RuleUnknownRouteKind, gw,
+					fmt.Sprintf("listener %d selects kind %q, which is not a route kind in group %s", i, kind, v1alpha1.GroupName))
+			case !topology.ProtocolSupportsKind(listener.Protocol, kind):
+				l.report(RuleIncompatibleRouteKind, gw,
+					fmt.Sprintf("listener %d selects %s routes, which cannot serve the %s protocol", i, kind, listener.Protocol))
+			}
+		}
+	}
+}
+
+// checkRoutes reports routes that are not bound to any listener,
+// gatewayRefs that refer to missing Gateways, and backends in other
+// namespaces that no ReferenceGrant allows.
+func (l *linter) checkRoutes() {
+	for _, route := range l.res.Routes() {
+		for i, rule := range route.Rules {
+			for j, b := range rule.ForwardTo {
+				if rb := l.res.ResolveBackend(route, b); rb.NotPermitted {
+					l.report(RuleReferenceNotPermitted, route.Object,
+						fmt.Sprintf("rule %d forwardTo %d refers to %s: %s", i, j, b, rb.Message))
+				}
+			}
+		}
+
+		if route.Gateways.Allow == v1alpha1.GatewayAllowFromList {
+			for _, ref := range route.Gateways.GatewayRefs {
+				if l.res.Gateway(ref.Namespace, ref.Name) == nil {
+					l.report(RuleMissingGateway, route.Object,
+						fmt.Sprintf("gateway %s/%s does not exist", ref.Namespace, ref.Name))
+				}
+			}
+		}
+
+		var reasons []string
+		bound := false
+		for _, a := range l.res.Attachments(route) {
+			if a.Bound {
+				bound = true
+				break
+			}
+			reasons = append(reasons, fmt.Sprintf("gateway %s/%s listener %d: %s",
+				a.Gateway.Namespace, a.Gateway.Name, a.ListenerIndex, a.Message))
+		}
+		if bound {
+			continue
+		}
+
+		message := "no gateway listener selects this route"
+		if len(reasons) > 0 {
+			message = "route is rejected by every gateway listener that could bind it: " + strings.Join(reasons, "; ")
+		}
+		l.report(RuleUnboundRoute, route.Object, message)
+	}
+}
+
+// matchKey identifies a single HTTP request match for the purpose of
+// detecting duplicates.
+func matchKey(hostname string, m v1alpha1.HTTPRouteMatch) string {
+	pathType := m.Path.Type
+	if pathType == "" {
+		pathType = v1alpha1.PathMatchPrefix
+	}
+	path := m.Path.Value
+	if path == "" {
+		path = "/"
+	}
+
+	key := fmt.Sprintf("hostname %q, path %s %q", hostname, pathType, path)
+
+	if m.Headers != nil {
+		headerType := m.Headers.Type
+		if headerType == "" {
+			headerType = v1alpha1.HeaderMatchExact
+		}
+		var headers []string
+		for name, value := range m.Headers.Values {
+			headers = append(headers, fmt.Sprintf("%s %s %s", strings.ToLower(name), headerType, value))
+		}
+		for _, matcher := range m.Headers.Matchers {
+			matcherType := matcher.Type
+			if matcherType == "" {
+				matcherType = v1alpha1.HeaderMatchExact
+			}
+			headers = append(headers, fmt.Sprintf("%s %s %s", strings.ToLower(matcher.Name), matcherType, matcher.Value))
+		}
+		if len(headers) > 0 {
+			sort.Strings(headers)
+			key += fmt.Sprintf(", headers %s", strings.Join(headers, ","))
+		}
+	}
+
+	if m.Method != nil {
+		methodType := m.Method.Type
+		if methodType == "" {
+			methodType = v1alpha1.MethodMatchExact
+		}
+		key += fmt.Sprintf(", method %s %q", methodType, m.Method.Value)
+	}
+
+	if m.QueryParams != nil && len(m.QueryParams.Values) > 0 {
+		var params []string
+		for name, value := range m.QueryParams.Values {
+			params = append(params, fmt.Sprintf("%s=%s", name, value))
+		}
+		sort.Strings(params)
+		queryType := m.QueryParams.Type
+		if queryType == "" {
+			queryType = v1alpha1.QueryParamMatchExact
+		}
+		key += fmt.Sprintf(", query %s %s", queryType, strings.Join(params, "&"))
+	}
+
+	if m.ExtensionRef != nil {
+		key += fmt.Sprintf(", extension %s/%s", m.ExtensionRef.Kind, m.ExtensionRef.Name)
+	}
+
+	return key
+}
+
+// tlsMatchKey identifies a single TLS connection match for the purpose
+// of detecting duplicates.
+func tlsMatchKey(sni string, m v1alpha1.TLSRouteMatch) string {
+	key := fmt.Sprintf("SNI %q", sni)
+
+	if m.Port != nil {
+		key += fmt.Sprintf(", port %d", *m.Port)
+	}
+
+	if len(m.ALPNProtocols) > 0 {
+		protos := append([]string(nil), m.ALPNProtocols...)
+		sort.Strings(protos)
+		key += fmt.Sprintf(", ALPN %s", strings.Join(protos, ","))
+	}
+
+	if m.ExtensionRef != nil {
+		key += fmt.Sprintf(", extension %s/%s", m.ExtensionRef.Kind, m.ExtensionRef.Name)
+	}
+
+	return key
+}
+
+// ruleMatchKeys returns the match keys of each rule of an HTTPRoute or a
+// TLSRoute, indexed by rule. It returns nil for other route kinds.
+func ruleMatchKeys(route *topology.Route) [][]string {
+	var keys [][]string
+
+	switch obj := route.Object.(type) {
+	case *v1alpha1.HTTPRoute:
+		hostnames := route.Hostnames
+		if len(hostnames) == 0 {
+			hostnames = []string{"*"}
+		}
+		for _, rule := range obj.Spec.Rules {
+			matches := rule.Matches
+			if len(matches) == 0 {
+				matches = []v1alpha1.HTTPRouteMatch{{}}
+			}
+			var ruleKeys []string
+			for _, hostname := range hostnames {
+				for _, m := range matches {
+					ruleKeys = append(ruleKeys, matchKey(hostname, m))
+				}
+			}
+			keys = append(keys, ruleKeys)
+		}
+	case *v1alpha1.TLSRoute:
+		for _, rule := range obj.Spec.Rules {
+			matches := rule.Matches
+			if len(matches) == 0 {
+				matches = []v1alpha1.TLSRouteMatch{{}}
+			}
+			var ruleKeys []string
+			for _, m := range matches {
+				snis := m.SNIs
+				if len(snis) == 0 {
+					snis = []string{"*"}
+				}
+				for _, sni := range snis {
+					ruleKeys = append(ruleKeys, tlsMatchKey(sni, m))
+				}
+			}
+			keys = append(keys, ruleKeys)
+		}
+	}
+
+	return keys
+}
+
+// checkDuplicates reports HTTPRoutes and TLSRoutes bound to the same
+// listener that have identical matches.
+func (l *linter) checkDuplicates() {
+	for i := range l.res.Gateways {
+		gw := &l.res.Gateways[i]
+		for _, lb := range l.res.Bind(gw).Listeners {
+			seen := map[string]string{}
+
+			for _, route := range lb.Routes {
+				owner := fmt.Sprintf("%s %s/%s", route.Kind, route.Namespace, route.Name)
+
+				reported := map[string]bool{}
+				for ruleIndex, keys := range ruleMatchKeys(route) {
+					for _, key := range keys {
+						where := fmt.Sprintf("%s rule %d", owner, ruleIndex)
+						prev, found := seen[key]
+						if !found {
+							seen[key] = where
+							continue
+						}
+						if reported[key] {
+							continue
+						}
+						reported[key] = true
+						l.report(RuleDuplicateMatch, route.Object,
+							fmt.Sprintf("%s on gateway %s/%s listener %d is already matched by %s",
+								key, gw.Namespace, gw.Name, lb.Index, prev))
+					}
+				}
+			}
+		}
+	}
+}
+
+// sourceBlock is a source CIDR block of a TCPRoute or UDPRoute match.
+type sourceBlock struct {
+	where   string
+	route   *topology.Route
+	cidr    string
+	network *net.IPNet
+	port    *int32
+}
+
+// sourceBlocks returns the valid source CIDR blocks of the matches of a
+// TCPRoute or UDPRoute. It returns nil for other route kinds.
+func sourceBlocks(route *topology.Route) []sourceBlock {
+	owner := fmt.Sprintf("%s %s/%s", route.Kind, route.Namespace, route.Name)
+
+	var blocks []sourceBlock
+	add := func(rule int, cidrs []string, port *int32) {
+		for _, cidr := range cidrs {
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				continue
+			}
+			blocks = append(blocks, sourceBlock{fmt.Sprintf("%s rule %d", owner, rule), route, cidr, network, port})
+		}
+	}
+
+	switch obj := route.Object.(type) {
+	case *v1alpha1.TCPRoute:
+		for i, rule := range obj.Spec.Rules {
+			for _, m := range rule.Matches {
+				add(i, m.SourceCIDRs, m.Port)
+			}
+		}
+	case *v1alpha1.UDPRoute:
+		for i, rule := range obj.Spec.Rules {
+			for _, m := range rule.Matches {
+				add(i, m.SourceCIDRs, m.Port)
+			}
+		}
+	}
+
+	return blocks
+}
+
+// checkSourceOverlaps reports TCPRoutes and UDPRoutes bound to the same
+// listener whose matches accept the same port and overlapping source
+// CIDR blocks. Connections from the overlap are served by whichever
+// route has the longer prefix, which is rarely what both route owners
+// intend.
+func (l *linter) checkSourceOverlaps() {
+	for i := range l.res.Gateways {
+		gw := &l.res.Gateways[i]
+		for _, lb := range l.res.Bind(gw).Listeners {
+			var seen []sourceBlock
+
+			for _, route := range lb.Routes {
+				blocks := sourceBlocks(route)
+				for _, b := range blocks {
+					for _, prev := range seen {
+						if !portsOverlap(prev.port, b.port) || !networksOverlap(prev.network, b.network) {
+							continue
+						}
+						l.report(RuleOverlappingSourceCIDR, route.Object,
+							fmt.Sprintf("source %s on gateway %s/%s listener %d overlaps source %s of %s",
+								b.cidr, gw.Namespace, gw.Name, lb.Index, prev.cidr, prev.where))
+					}
+				}
+				seen = append(seen, blocks...)
+			}
+		}
+	}
+}
+
+// portsOverlap reports whether two match ports accept a common port. A
+// nil port accepts every port.
+func portsOverlap(a, b *int32) bool {
+	return a == nil || b == nil || *a == *b
+}
+
+// networksOverlap reports whether two CIDR blocks share an address.
+func networksOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// checkFields reports objects that fail validation of the constraints
+// between their fields.
+func (l *linter) checkFields() {
+	for i := range l.res.Gateways {
+		gateway := &l.res.Gateways[i]
+		for _, err := range validation.ValidateGateway(gateway) {
+			l.report(RuleInvalidField, gateway, err.Error())
+		}
+	}
+	for i := range l.res.HTTPRoutes {
+		route := &l.res.HTTPRoutes[i]
+		for _, err := range validation.ValidateHTTPRoute(route) {
+			l.report(RuleInvalidField, route, err.Error())
+		}
+	}
+	for i := range l.res.GRPCRoutes {
+		route := &l.res.GRPCRoutes[i]
+		for _, err := range validation.ValidateGRPCRoute(route) {
+			l.report(RuleInvalidField, route, err.Error())
+		}
+	}
+	for i := range l.res.TLSRoutes {
+		route := &l.res.TLSRoutes[i]
+		for _, err := range validation.ValidateTLSRoute(route) {
+			l.report(RuleInvalidField, route, err.Error())
+		}
+	}
+	for i := range l.res.TCPRoutes {
+		route := &l.res.TCPRoutes[i]
+		for _, err := range validation.ValidateTCPRoute(route) {
+			l.report(RuleInvalidField, route, err.Error())
+		}
+	}
+	for i := range l.res.UDPRoutes {
+		route := &l.res.UDPRoutes[i]
+		for _, err := range validation.ValidateUDPRoute(route) {
+			l.report(RuleInvalidField, route, err.Error())
+		}
+	}
+	for i := range l.res.BackendPolicies {
+		policy := &l.res.BackendPolicies[i]
+		for _, err := range validation.ValidateBackendPolicy(policy) {
+			l.report(RuleInvalidField, policy, err.Error())
+		}
+	}
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lint validates a set of service-apis manifests as a whole. The
+// checks cover the relationships between objects, and the constraints
+// between fields of an object, which schema validation cannot catch.
+package lint
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/manifest"
+	"sigs.k8s.io/service-apis/pkg/topology"
+)
+
+// Severity is the severity of a Finding.
+type Severity string
+
+const (
+	// SeverityError is used for configurations that are invalid or
+	// conflicting.
+	SeverityError Severity = "error"
+
+	// SeverityWarning is used for configurations that are likely to be
+	// mistakes, but may be valid once combined with objects that are not
+	// part of the linted manifests.
+	SeverityWarning Severity = "warning"
+)
+
+// Rule describes a class of problems detected by the linter.
+type Rule struct {
+	ID          string
+	Severity    Severity
+	Description string
+}
+
+// Rules detected by the linter.
+var (
+	RuleUnboundRoute = Rule{
+		ID:          "unbound-route",
+		Severity:    SeverityWarning,
+		Description: "Route is not bound to any Gateway listener.",
+	}
+	RuleMissingGateway = Rule{
+		ID:          "missing-gateway",
+		Severity:    SeverityWarning,
+		Description: "Route gatewayRefs entry refers to a Gateway that does not exist.",
+	}
+	RuleUnknownRouteKind = Rule{
+		ID:          "unknown-route-kind",
+		Severity:    SeverityError,
+		Description: "Listener selects a route kind that does not exist.",
+	}
+	RuleIncompatibleRouteKind = Rule{
+		ID:          "incompatible-route-kind",
+		Severity:    SeverityError,
+		Description: "Listener selects a route kind that cannot serve the listener protocol.",
+	}
+	RuleUnresolvedReference = Rule{
+		ID:          "unresolved-reference",
+		Severity:    SeverityWarning,
+		Description: "Object reference does not resolve to any object.",
+	}
+	RuleDuplicateMatch = Rule{
+		ID:          "duplicate-match",
+		Severity:    SeverityError,
+		Description: "Routes bound to the same listener have identical matches.",
+	}
+	RuleOverlappingSourceCIDR = Rule{
+		ID:          "overlapping-source-cidr",
+		Severity:    SeverityWarning,
+		Description: "TCPRoutes or UDPRoutes bound to the same listener match overlapping source CIDR blocks.",
+	}
+	RuleInvalidField = Rule{
+		ID:          "invalid-field",
+		Severity:    SeverityError,
+		Description: "Object violates a constraint between its fields that the CRD schema cannot check.",
+	}
+	RuleReferenceNotPermitted = Rule{
+		ID:          "reference-not-permitted",
+		Severity:    SeverityError,
+		Description: "Object references an object in another namespace that no ReferenceGrant allows.",
+	}
+)
+
+// Rules lists every rule detected by the linter.
+var Rules = []Rule{
+	RuleUnboundRoute,
+	RuleMissingGateway,
+	RuleUnknownRouteKind,
+	RuleIncompatibleRouteKind,
+	RuleUnresolvedReference,
+	RuleDuplicateMatch,
+	RuleOverlappingSourceCIDR,
+	RuleInvalidField,
+	RuleReferenceNotPermitted,
+}
+
+// Finding is a problem detected in a manifest object.
+type Finding struct {
+	RuleID   string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Object   string   `json:"object"`
+	File     string   `json:"file"`
+	Line     int      `json:"line"`
+	Message  string   `json:"message"`
+}
+
+// linter holds the state of a single lint run.
+type linter struct {
+	objects []manifest.Object
+	res     *topology.Resources
+
+	// origins maps the objects in res to the manifest objects they
+	// were copied from.
+	origins map[runtime.Object]*manifest.Object
+
+	findings []Finding
+}
+
+// Run checks the objects together and returns the problems it finds,
+// ordered by file and line.
+func Run(objects []manifest.Object) []Finding {
+	l := &linter{
+		objects: objects,
+		res:     &topology.Resources{},
+		origins: map[runtime.Object]*manifest.Object{},
+	}
+	for _, o := range objects {
+		l.res.Add(o.Object)
+	}
+	l.indexOrigins()
+
+	l.checkListeners()
+	l.checkRoutes()
+	l.checkReferences()
+	l.checkDuplicates()
+	l.checkSourceOverlaps()
+	l.checkFields()
+
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		a, b := l.findings[i], l.findings[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line
+	})
+
+	return l.findings
+}
+
+// HasErrors reports whether any finding has error severity.
+func HasErrors(findings []Finding) bool {
+	for _, f := range findings {
+		if f.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// indexOrigins populates origins. Resources.Add appends objects in
+// order, so the n-th object of a type in res is the n-th object of that
+// type in the manifests.
+func (l *linter) indexOrigins() {
+	var n struct{ classes, gateways, http, grpc, tcp, tls, udp, policies int }
+
+	for i := range l.objects {
+		o := &l.objects[i]
+		switch o.Object.(type) {
+		case *v1alpha1.GatewayClass:
+			l.origins[&l.res.GatewayClasses[n.classes]] = o
+			n.classes++
+		case *v1alpha1.Gateway:
+			l.origins[&l.res.Gateways[n.gateways]] = o
+			n.gateways++
+		case *v1alpha1.HTTPRoute:
+			l.origins[&l.res.HTTPRoutes[n.http]] = o
+			n.http++
+		case *v1alpha1.GRPCRoute:
+			l.origins[&l.res.GRPCRoutes[n.grpc]] = o
+			n.grpc++
+		case *v1alpha1.TCPRoute:
+			l.origins[&l.res.TCPRoutes[n.tcp]] = o
+			n.tcp++
+		case *v1alpha1.TLSRoute:
+			l.origins[&l.res.TLSRoutes[n.tls]] = o
+			n.tls++
+		case *v1alpha1.UDPRoute:
+			l.origins[&l.res.UDPRoutes[n.udp]] = o
+			n.udp++
+		case *v1alpha1.BackendPolicy:
+			l.origins[&l.res.BackendPolicies[n.policies]] = o
+			n.policies++
+		}
+	}
+}
+
+// report records a finding for obj, which must be one of the objects in
+// the linter's Resources.
+func (l *linter) report(rule Rule, obj runtime.Object, message string) {
+	f := Finding{
+		RuleID:   rule.ID,
+		Severity: rule.Severity,
+		Message:  message,
+	}
+
+	if o := l.origins[obj]; o != nil {
+		f.Object = o.String()
+		f.File = o.File
+		f.Line = o.Line
+	}
+
+	l.findings = append(l.findings, f)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"fmt"
+	"testing"
+
+	"sigs.k8s.io/service-apis/pkg/manifest"
+)
+
+func TestRun(t *testing.T) {
+	objects, err := manifest.Load("testdata/problems.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]bool{}
+	for _, f := range Run(objects) {
+		got[fmt.Sprintf("%s %s:%d %s", f.RuleID, f.File, f.Line, f.Object)] = true
+	}
+
+	want := []string{
+		"incompatible-route-kind testdata/problems.yaml:8 Gateway infra/gateway",
+		"unknown-route-kind testdata/problems.yaml:8 Gateway infra/gateway",
+		"duplicate-match testdata/problems.yaml:60 HTTPRoute team-a/web-copy",
+		"unresolved-reference testdata/problems.yaml:60 HTTPRoute team-a/web-copy",
+		"missing-gateway testdata/problems.yaml:80 HTTPRoute team-b/orphan",
+		"unbound-route testdata/problems.yaml:80 HTTPRoute team-b/orphan",
+		"unresolved-reference testdata/problems.yaml:80 HTTPRoute team-b/orphan",
+		"reference-not-permitted testdata/problems.yaml:80 HTTPRoute team-b/orphan",
+		"reference-not-permitted testdata/problems.yaml:114 Gateway infra/secure",
+		"unresolved-reference testdata/problems.yaml:114 Gateway infra/secure",
+		"unresolved-reference testdata/problems.yaml:148 TCPRoute team-a/internal",
+		"overlapping-source-cidr testdata/problems.yaml:164 TCPRoute team-b/office",
+		"unresolved-reference testdata/problems.yaml:164 TCPRoute team-b/office",
+	}
+
+	for _, w := range want {
+		if !got[w] {
+			t.Errorf("missing finding %q", w)
+		}
+		delete(got, w)
+	}
+	for g := range got {
+		t.Errorf("unexpected finding %q", g)
+	}
+}
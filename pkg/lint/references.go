@@ -0,0 +1,228 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/topology"
+)
+
+// objectKey identifies an object among the linted manifests.
+type objectKey struct {
+	group, kind, namespace, name string
+}
+
+// coreGroup normalizes the names used for the core API group.
+func coreGroup(group string) string {
+	if group == "core" {
+		return ""
+	}
+	return group
+}
+
+// referenceChecker resolves object references against the linted objects.
+type referenceChecker struct {
+	*linter
+	known map[objectKey]bool
+}
+
+// checkReferences reports LocalObjectReferences, BackendRefs and service
+// names that do not resolve to any of the linted objects.
+func (l *linter) checkReferences() {
+	c := &referenceChecker{linter: l, known: map[objectKey]bool{}}
+	for i := range l.objects {
+		o := &l.objects[i]
+		m := o.Meta()
+		c.known[objectKey{
+			group:     coreGroup(o.GroupVersionKind.Group),
+			kind:      o.GroupVersionKind.Kind,
+			namespace: m.GetNamespace(),
+			name:      m.GetName(),
+		}] = true
+	}
+
+	for i := range l.res.GatewayClasses {
+		gc := &l.res.GatewayClasses[i]
+		if ref := gc.Spec.ParametersRef; ref != nil {
+			c.check(gc, "", "parametersRef", "", ref.Group, ref.Kind, ref.Name)
+		}
+	}
+
+	for i := range l.res.Gateways {
+		gw := &l.res.Gateways[i]
+		for j, listener := range gw.Spec.Listeners {
+			if listener.TLS != nil && listener.TLS.CertificateRef.Name != "" {
+				from := topology.Reference{Group: v1alpha1.GroupName, Kind: "Gateway", Namespace: gw.Namespace, Name: gw.Name}
+				c.checkCertificate(gw, from, fmt.Sprintf("listener %d certificateRef", j), listener.TLS.CertificateRef,
+					"the listener must be reported with reason "+string(v1alpha1.ListenerReasonInvalidCertificateRef))
+			}
+			if listener.TLS != nil && listener.TLS.ClientValidation != nil {
+				ref := listener.TLS.ClientValidation.CACertificateRef
+				c.check(gw, gw.Namespace, fmt.Sprintf("listener %d caCertificateRef", j), "Secret", ref.Group, ref.Kind, ref.Name)
+			}
+		}
+	}
+
+	for i := range l.res.HTTPRoutes {
+		route := &l.res.HTTPRoutes[i]
+		ns := route.Namespace
+		if route.Spec.TLS != nil {
+			from := topology.Reference{Group: v1alpha1.GroupName, Kind: topology.KindHTTPRoute, Namespace: ns, Name: route.Name}
+			c.checkCertificate(route, from, "tls certificateRef", route.Spec.TLS.CertificateRef,
+				"the route must be reported with reason "+string(v1alpha1.RouteReasonRefNotPermitted))
+		}
+		for j, rule := range route.Spec.Rules {
+			for _, m := range rule.Matches {
+				c.checkLocal(route, ns, fmt.Sprintf("rule %d match extensionRef", j), "ConfigMap", m.ExtensionRef)
+			}
+			c.checkFilters(route, fmt.Sprintf("rule %d", j), rule.Filters)
+			for k, f := range rule.ForwardTo {
+				field := fmt.Sprintf("rule %d forwardTo %d", j, k)
+				c.checkBackend(route, ns, field, f.ServiceName, f.BackendRef, f.Namespace)
+				c.checkFilters(route, field, f.Filters)
+			}
+		}
+	}
+
+	for i := range l.res.GRPCRoutes {
+		route := &l.res.GRPCRoutes[i]
+		for j, rule := range route.Spec.Rules {
+			c.checkForwardTo(route, route.Namespace, j, rule.ForwardTo)
+		}
+	}
+
+	for i := range l.res.TCPRoutes {
+		route := &l.res.TCPRoutes[i]
+		for j, rule := range route.Spec.Rules {
+			for _, m := range rule.Matches {
+				c.checkLocal(route, route.Namespace, fmt.Sprintf("rule %d match extensionRef", j), "ConfigMap", m.ExtensionRef)
+			}
+			c.checkForwardTo(route, route.Namespace, j, rule.ForwardTo)
+		}
+	}
+
+	for i := range l.res.TLSRoutes {
+		route := &l.res.TLSRoutes[i]
+		for j, rule := range route.Spec.Rules {
+			for _, m := range rule.Matches {
+				c.checkLocal(route, route.Namespace, fmt.Sprintf("rule %d match extensionRef", j), "ConfigMap", m.ExtensionRef)
+			}
+			c.checkForwardTo(route, route.Namespace, j, rule.ForwardTo)
+		}
+	}
+
+	for i := range l.res.UDPRoutes {
+		route := &l.res.UDPRoutes[i]
+		for j, rule := range route.Spec.Rules {
+			for _, m := range rule.Matches {
+				c.checkLocal(route, route.Namespace, fmt.Sprintf("rule %d match extensionRef", j), "ConfigMap", m.ExtensionRef)
+			}
+			c.checkForwardTo(route, route.Namespace, j, rule.ForwardTo)
+		}
+	}
+
+	for i := range l.res.BackendPolicies {
+		policy := &l.res.BackendPolicies[i]
+		ns := policy.Namespace
+		for j, ref := range policy.Spec.BackendRefs {
+			c.check(policy, ns, fmt.Sprintf("backendRef %d", j), "Service", ref.Group, ref.Kind, ref.Name)
+		}
+		if tls := policy.Spec.TLS; tls != nil {
+			c.checkLocal(policy, ns, "tls clientCertificateRef", "Secret", tls.ClientCertificateRef)
+			c.checkLocal(policy, ns, "tls certificateAuthorityRef", "Secret", tls.CertificateAuthorityRef)
+		}
+	}
+}
+
+func (c *referenceChecker) checkFilters(route *v1alpha1.HTTPRoute, field string, filters []v1alpha1.HTTPRouteFilter) {
+	for i, f := range filters {
+		filterField := fmt.Sprintf("%s filter %d", field, i)
+		c.checkLocal(route, route.Namespace, filterField+" extensionRef", "ConfigMap", f.ExtensionRef)
+		if f.RequestMirror != nil {
+			c.checkBackend(route, route.Namespace, filterField+" requestMirror", f.RequestMirror.ServiceName, f.RequestMirror.BackendRef, nil)
+		}
+	}
+}
+
+func (c *referenceChecker) checkForwardTo(obj runtime.Object, namespace string, rule int, targets []v1alpha1.RouteForwardTo) {
+	for i, f := range targets {
+		c.checkBackend(obj, namespace, fmt.Sprintf("rule %d forwardTo %d", rule, i), f.ServiceName, f.BackendRef, f.Namespace)
+	}
+}
+
+// checkBackend checks a forwarding target of a route in the given
+// namespace. The namespace of the target takes precedence, and ServiceName
+// takes precedence over BackendRef, as specified by the API.
+func (c *referenceChecker) checkBackend(obj runtime.Object, namespace, field string, serviceName *string, ref *v1alpha1.LocalObjectReference, targetNamespace *string) {
+	if targetNamespace != nil {
+		namespace = *targetNamespace
+	}
+	if serviceName != nil {
+		c.check(obj, namespace, field+" serviceName", "Service", "", "Service", *serviceName)
+		return
+	}
+	c.checkLocal(obj, namespace, field+" backendRef", "Service", ref)
+}
+
+// checkCertificate checks a certificate reference of from, and reports it
+// if it refers to another namespace that no ReferenceGrant allows. consequence
+// describes the status a controller reports for the denied reference.
+func (c *referenceChecker) checkCertificate(obj runtime.Object, from topology.Reference, field string, ref v1alpha1.CertificateObjectReference, consequence string) {
+	to := topology.CertificateReference(from.Namespace, ref)
+	c.check(obj, to.Namespace, field, "Secret", ref.Group, ref.Kind, ref.Name)
+	if !c.res.ReferenceGranted(from, to) {
+		c.report(RuleReferenceNotPermitted, obj, fmt.Sprintf("%s refers to %s %s/%s, but no ReferenceGrant in namespace %q allows it; %s",
+			field, to.Kind, to.Namespace, to.Name, to.Namespace, consequence))
+	}
+}
+
+func (c *referenceChecker) checkLocal(obj runtime.Object, namespace, field, defaultKind string, ref *v1alpha1.LocalObjectReference) {
+	if ref != nil {
+		c.check(obj, namespace, field, defaultKind, ref.Group, ref.Kind, ref.Name)
+	}
+}
+
+// check reports the reference from obj if it does not resolve. Omitting
+// both the group and the kind selects defaultKind in the core group.
+// References from cluster-scoped objects (namespace "") are resolved
+// against cluster-scoped objects.
+func (c *referenceChecker) check(obj runtime.Object, namespace, field, defaultKind, refGroup, refKind, refName string) {
+	if refGroup == "" && refKind == "" {
+		refKind = defaultKind
+	}
+
+	key := objectKey{group: coreGroup(refGroup), kind: refKind, namespace: namespace, name: refName}
+	if c.known[key] {
+		return
+	}
+
+	target := refKind + " " + refName
+	if coreGroup(refGroup) != "" {
+		target = refKind + "." + refGroup + " " + refName
+	}
+	where := "in the manifests"
+	if namespace != "" {
+		where = fmt.Sprintf("in namespace %q", namespace)
+	}
+
+	c.report(RuleUnresolvedReference, obj,
+		fmt.Sprintf("%s refers to %s, which does not exist %s", field, target, where))
+}
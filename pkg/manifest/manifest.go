@@ -0,0 +1,279 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manifest loads Kubernetes objects from multi-document YAML
+// files, so that service-apis resources can be inspected without a
+// cluster.
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/apis/v1alpha2"
+)
+
+// Object is a Kubernetes object decoded from a manifest file.
+type Object struct {
+	runtime.Object
+
+	// GroupVersionKind is the type of the object as declared in the
+	// manifest.
+	GroupVersionKind schema.GroupVersionKind
+
+	// File is the path of the manifest the object was read from.
+	File string
+
+	// Line is the line of File on which the object's YAML document
+	// starts, counting from 1.
+	Line int
+}
+
+// Meta returns the object metadata.
+func (o *Object) Meta() metav1.Object {
+	m, err := meta.Accessor(o.Object)
+	if err != nil {
+		// Every type that the decoder produces has object metadata.
+		panic(err)
+	}
+	return m
+}
+
+// String formats the object as "Kind namespace/name".
+func (o *Object) String() string {
+	m := o.Meta()
+	if m.GetNamespace() == "" {
+		return o.GroupVersionKind.Kind + " " + m.GetName()
+	}
+	return o.GroupVersionKind.Kind + " " + m.GetNamespace() + "/" + m.GetName()
+}
+
+// clusterScopedKinds lists the kinds the loader knows to be cluster
+// scoped. All other objects default to the "default" namespace.
+var clusterScopedKinds = map[schema.GroupKind]bool{
+	{Group: v1alpha1.GroupName, Kind: "GatewayClass"}: true,
+	{Group: "", Kind: "Namespace"}:                    true,
+}
+
+// Load reads every object from the given paths. Directories are
+// searched, without descending into subdirectories, for files ending in
+// ".yaml" or ".yml". Objects of kinds that are not registered with the
+// Kubernetes or service-apis schemes are returned as unstructured
+// objects. Objects of the v1alpha2 service-apis version are converted to
+// v1alpha1.
+func Load(paths ...string) ([]Object, error) {
+	s := runtime.NewScheme()
+	if err := scheme.AddToScheme(s); err != nil {
+		return nil, fmt.Errorf("failed to add builtin scheme: %w", err)
+	}
+	if err := v1alpha1.AddToScheme(s); err != nil {
+		return nil, fmt.Errorf("failed to add service-api scheme: %w", err)
+	}
+	if err := v1alpha2.AddToScheme(s); err != nil {
+		return nil, fmt.Errorf("failed to add service-api scheme: %w", err)
+	}
+	decoder := serializer.NewCodecFactory(s).UniversalDeserializer()
+
+	files, err := findFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []Object
+	for _, filename := range files {
+		objs, err := loadFile(s, decoder, filename)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, objs...)
+	}
+
+	return objects, nil
+}
+
+func findFiles(paths []string) ([]string, error) {
+	var filenames []string
+
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			filenames = append(filenames, p)
+			continue
+		}
+
+		for _, pattern := range []string{
+			filepath.Join(p, "*.yaml"),
+			filepath.Join(p, "*.yml"),
+		} {
+			matches, err := filepath.Glob(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("glob %q failed: %w", pattern, err)
+			}
+			sort.Strings(matches)
+			filenames = append(filenames, matches...)
+		}
+	}
+
+	return filenames, nil
+}
+
+func loadFile(s *runtime.Scheme, decoder runtime.Decoder, filename string) ([]Object, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	docs, err := splitDocuments(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read YAML from %q: %w", filename, err)
+	}
+
+	var objects []Object
+	line := 1
+	for n, doc := range docs {
+		start := line + leadingLines(doc)
+		line += bytes.Count(doc, []byte("\n"))
+
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj, gvk, err := decode(decoder, doc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode YAML object #%d from %q: %w", n, filename, err)
+		}
+		if hub, ok := obj.(conversion.Hub); ok {
+			if obj, err = fromHub(s, hub, gvk.Kind); err != nil {
+				return nil, fmt.Errorf("failed to convert YAML object #%d from %q: %w", n, filename, err)
+			}
+		}
+
+		m, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type for YAML object #%d from %q: %w", n, filename, err)
+		}
+		if m.GetNamespace() == "" && !clusterScopedKinds[gvk.GroupKind()] {
+			m.SetNamespace(metav1.NamespaceDefault)
+		}
+
+		objects = append(objects, Object{
+			Object:           obj,
+			GroupVersionKind: *gvk,
+			File:             filename,
+			Line:             start,
+		})
+	}
+
+	return objects, nil
+}
+
+// splitDocuments splits a multi-document YAML stream. Each document keeps
+// its trailing newlines so that line numbers can be recovered.
+func splitDocuments(r io.Reader) ([][]byte, error) {
+	var docs [][]byte
+
+	splitter := yaml.NewDocumentDecoder(ioutil.NopCloser(r))
+	defer splitter.Close()
+
+	var doc []byte
+	for {
+		buf := make([]byte, 4096)
+		nread, err := splitter.Read(buf)
+		doc = append(doc, buf[:nread]...)
+
+		switch err {
+		case io.ErrShortBuffer:
+			continue
+		case nil:
+			// The splitter consumes the newline that ends the
+			// document and the separator line, so account for
+			// them here.
+			docs = append(docs, append(doc, '\n', '\n'))
+			doc = nil
+		case io.EOF:
+			return docs, nil
+		default:
+			return nil, err
+		}
+	}
+}
+
+// leadingLines counts the blank, comment and document start lines that
+// precede the content of a YAML document.
+func leadingLines(doc []byte) int {
+	n := 0
+	for _, l := range bytes.Split(doc, []byte("\n")) {
+		t := bytes.TrimSpace(l)
+		if len(t) > 0 && t[0] != '#' && !bytes.HasPrefix(t, []byte("---")) {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// fromHub converts an object of the hub version of the service-apis
+// group to v1alpha1, the version the rest of the module works with.
+func fromHub(s *runtime.Scheme, hub conversion.Hub, kind string) (runtime.Object, error) {
+	obj, err := s.New(v1alpha1.SchemeGroupVersion.WithKind(kind))
+	if err != nil {
+		return nil, err
+	}
+	spoke, ok := obj.(conversion.Convertible)
+	if !ok {
+		return nil, fmt.Errorf("%s %s is not convertible", v1alpha1.SchemeGroupVersion, kind)
+	}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		return nil, err
+	}
+	spoke.GetObjectKind().SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(kind))
+	return spoke, nil
+}
+
+func decode(decoder runtime.Decoder, doc []byte) (runtime.Object, *schema.GroupVersionKind, error) {
+	obj, gvk, err := decoder.Decode(doc, nil, nil)
+	if err == nil || !runtime.IsNotRegisteredError(err) {
+		return obj, gvk, err
+	}
+
+	js, err := yaml.ToJSON(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return unstructured.UnstructuredJSONScheme.Decode(js, nil, nil)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+func TestLoad(t *testing.T) {
+	for _, path := range []string{"testdata", "testdata/objects.yaml"} {
+		objects, err := Load(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for i := range objects {
+			o := &objects[i]
+			got = append(got, fmt.Sprintf("%s %s %s:%d %T", o.GroupVersionKind.GroupVersion(), o.String(), o.File, o.Line, o.Object))
+		}
+		want := []string{
+			"networking.x-k8s.io/v1alpha2 GatewayClass acme testdata/objects.yaml:3 *v1alpha1.GatewayClass",
+			"networking.x-k8s.io/v1alpha1 Gateway infra/gateway testdata/objects.yaml:10 *v1alpha1.Gateway",
+			"networking.x-k8s.io/v1alpha2 HTTPRoute default/web testdata/objects.yaml:23 *v1alpha1.HTTPRoute",
+			"v1 Service default/web testdata/objects.yaml:45 *v1.Service",
+			"acme.io/v1 Widget team-a/widget testdata/objects.yaml:53 *unstructured.Unstructured",
+		}
+		if len(got) != len(want) {
+			t.Fatalf("Load(%q) returned %d objects, want %d:\n%v", path, len(got), len(want), got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("Load(%q) object %d is %q, want %q", path, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestLoadConvertsHub(t *testing.T) {
+	objects, err := Load("testdata/objects.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	class := objects[0].Object.(*v1alpha1.GatewayClass)
+	if class.Spec.Controller != "acme.io/gateway-controller" {
+		t.Errorf("GatewayClass controller is %q", class.Spec.Controller)
+	}
+	if class.APIVersion != v1alpha1.SchemeGroupVersion.String() || class.Kind != "GatewayClass" {
+		t.Errorf("converted GatewayClass has type %s", class.GroupVersionKind())
+	}
+
+	route := objects[2].Object.(*v1alpha1.HTTPRoute)
+	if len(route.Spec.Hostnames) != 1 || route.Spec.Hostnames[0] != "web.example.com" {
+		t.Errorf("HTTPRoute hostnames are %v", route.Spec.Hostnames)
+	}
+	if len(route.Spec.Rules) != 1 || len(route.Spec.Rules[0].ForwardTo) != 1 ||
+		*route.Spec.Rules[0].ForwardTo[0].ServiceName != "web" {
+		t.Errorf("HTTPRoute rules are %+v", route.Spec.Rules)
+	}
+	if len(route.Status.Gateways) != 1 {
+		t.Fatalf("HTTPRoute status has %d gateways, want 1", len(route.Status.Gateways))
+	}
+	status := route.Status.Gateways[0]
+	if status.GatewayRef.Namespace != "infra" || status.GatewayRef.Name != "gateway" {
+		t.Errorf("HTTPRoute status refers to gateway %+v, want infra/gateway", status.GatewayRef)
+	}
+	if len(status.Conditions) != 1 || status.Conditions[0].Status != metav1.ConditionTrue {
+		t.Errorf("HTTPRoute status conditions are %+v", status.Conditions)
+	}
+
+	if _, ok := objects[3].Object.(*corev1.Service); !ok {
+		t.Errorf("Service decoded as %T", objects[3].Object)
+	}
+	if _, ok := objects[4].Object.(*unstructured.Unstructured); !ok {
+		t.Errorf("unknown kind decoded as %T", objects[4].Object)
+	}
+}
@@ -0,0 +1,722 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulate
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/topology"
+)
+
+// Hostname specificity, from least to most specific.
+const (
+	hostnameAny = iota
+	hostnameWildcard
+	hostnamePrecise
+)
+
+// Path match specificity, from least to most specific.
+const (
+	pathRegularExpression = iota
+	pathPrefix
+	pathExact
+)
+
+// match is a route match that selects the request.
+type match struct {
+	route *topology.Route
+	rule  int
+	// index is the index of the match in the rule, or -1 if the rule
+	// has no matches.
+	index       int
+	description string
+
+	hostname int
+	// source is one more than the prefix length of the matched source
+	// CIDR block, or 0 if the match has no source CIDRs.
+	source      int
+	port        bool
+	alpn        bool
+	path        int
+	pathLen     int
+	method      bool
+	headers     int
+	queryParams int
+}
+
+func (m *match) name() string {
+	name := fmt.Sprintf("%s rule %d", routeName(m.route), m.rule)
+	if m.index >= 0 {
+		name += fmt.Sprintf(" match %d", m.index)
+	}
+	return name
+}
+
+// precedes reports whether m is more specific than o. Matches of equal
+// specificity are ordered by the caller.
+func (m *match) precedes(o *match) bool {
+	if m.hostname != o.hostname {
+		return m.hostname > o.hostname
+	}
+	if m.source != o.source {
+		return m.source > o.source
+	}
+	if m.port != o.port {
+		return m.port
+	}
+	if m.alpn != o.alpn {
+		return m.alpn
+	}
+	if m.path != o.path {
+		return m.path > o.path
+	}
+	if m.pathLen != o.pathLen {
+		return m.pathLen > o.pathLen
+	}
+	if m.method != o.method {
+		return m.method
+	}
+	if m.headers != o.headers {
+		return m.headers > o.headers
+	}
+	return m.queryParams > o.queryParams
+}
+
+// matchRoute returns the matches of the route that select the request,
+// and records why the other matches do not.
+func (r *Result) matchRoute(route *topology.Route, req *Request, protocol v1alpha1.ProtocolType) []*match {
+	switch obj := route.Object.(type) {
+	case *v1alpha1.HTTPRoute:
+		return r.matchHTTPRoute(route, obj, req)
+	case *v1alpha1.GRPCRoute:
+		return r.matchGRPCRoute(route, obj, req)
+	case *v1alpha1.TLSRoute:
+		return r.matchTLSRoute(route, obj, req)
+	case *v1alpha1.TCPRoute:
+		var matches [][]l4Match
+		for _, rule := range obj.Spec.Rules {
+			var ruleMatches []l4Match
+			for _, m := range rule.Matches {
+				ruleMatches = append(ruleMatches, l4Match{m.SourceCIDRs, m.Port, m.ExtensionRef})
+			}
+			matches = append(matches, ruleMatches)
+		}
+		return r.matchL4Route(route, matches, req)
+	case *v1alpha1.UDPRoute:
+		var matches [][]l4Match
+		for _, rule := range obj.Spec.Rules {
+			var ruleMatches []l4Match
+			for _, m := range rule.Matches {
+				ruleMatches = append(ruleMatches, l4Match{m.SourceCIDRs, m.Port, m.ExtensionRef})
+			}
+			matches = append(matches, ruleMatches)
+		}
+		return r.matchL4Route(route, matches, req)
+	}
+
+	r.reject(routeName(route), fmt.Sprintf("cannot simulate %s routes on %s listeners", route.Kind, protocol))
+	return nil
+}
+
+// matchHTTPRoute matches the request against the hostnames and then the
+// rules of the route. A rule without matches selects every request.
+func (r *Result) matchHTTPRoute(route *topology.Route, obj *v1alpha1.HTTPRoute, req *Request) []*match {
+	hostname, ok := routeHostname(route.Hostnames, req.Host)
+	if !ok {
+		r.reject(routeName(route), fmt.Sprintf("host %q does not match hostnames %s", req.Host, strings.Join(route.Hostnames, ", ")))
+		return nil
+	}
+
+	var matches []*match
+	for i, rule := range obj.Spec.Rules {
+		if len(rule.Matches) == 0 {
+			matches = append(matches, &match{
+				route:       route,
+				rule:        i,
+				index:       -1,
+				description: "any request",
+				hostname:    hostname,
+				path:        pathPrefix,
+				pathLen:     1,
+			})
+			continue
+		}
+
+		for j, m := range rule.Matches {
+			candidate := &match{route: route, rule: i, index: j, hostname: hostname}
+			if reason := candidate.matchHTTP(m, req); reason != "" {
+				r.reject(candidate.name(), reason)
+				continue
+			}
+			matches = append(matches, candidate)
+		}
+	}
+	return matches
+}
+
+// matchHTTP evaluates a single HTTPRouteMatch. It returns why the match
+// does not select the request, or the empty string if it does.
+func (m *match) matchHTTP(hm v1alpha1.HTTPRouteMatch, req *Request) string {
+	pathType := hm.Path.Type
+	if pathType == "" {
+		pathType = v1alpha1.PathMatchPrefix
+	}
+	value := hm.Path.Value
+	if value == "" {
+		value = "/"
+	}
+
+	switch pathType {
+	case v1alpha1.PathMatchExact:
+		if req.Path != value {
+			return fmt.Sprintf("path %q does not equal %q", req.Path, value)
+		}
+		m.path = pathExact
+	case v1alpha1.PathMatchPrefix:
+		if !pathHasPrefix(req.Path, value) {
+			return fmt.Sprintf("path %q does not have prefix %q", req.Path, value)
+		}
+		m.path = pathPrefix
+	case v1alpha1.PathMatchRegularExpression:
+		matched, err := matchRegularExpression(value, req.Path)
+		if err != nil {
+			return fmt.Sprintf("cannot evaluate path regular expression %q: %v", value, err)
+		}
+		if !matched {
+			return fmt.Sprintf("path %q does not match regular expression %q", req.Path, value)
+		}
+		m.path = pathRegularExpression
+	default:
+		return fmt.Sprintf("cannot evaluate %s path match %q", pathType, value)
+	}
+	m.pathLen = len(value)
+	descriptions := []string{fmt.Sprintf("path %s %q", pathType, value)}
+
+	if hm.Headers != nil {
+		headers, reason := matchHeaders(*hm.Headers, req.Headers)
+		if reason != "" {
+			return reason
+		}
+		if m.headers = len(headers); m.headers > 0 {
+			descriptions = append(descriptions, "headers "+strings.Join(headers, ","))
+		}
+	}
+
+	if mm := hm.Method; mm != nil {
+		switch mm.Type {
+		case "", v1alpha1.MethodMatchExact:
+			if req.Method != mm.Value {
+				return fmt.Sprintf("method %s is not %s", req.Method, mm.Value)
+			}
+		case v1alpha1.MethodMatchRegularExpression:
+			matched, err := matchRegularExpression(mm.Value, req.Method)
+			if err != nil {
+				return fmt.Sprintf("cannot evaluate method regular expression %q: %v", mm.Value, err)
+			}
+			if !matched {
+				return fmt.Sprintf("method %s does not match regular expression %q", req.Method, mm.Value)
+			}
+		default:
+			return fmt.Sprintf("cannot evaluate %s method match", mm.Type)
+		}
+		m.method = true
+		descriptions = append(descriptions, fmt.Sprintf("method %s %q", methodMatchType(mm.Type), mm.Value))
+	}
+
+	if qm := hm.QueryParams; qm != nil && len(qm.Values) > 0 {
+		regular := false
+		switch qm.Type {
+		case "", v1alpha1.QueryParamMatchExact:
+		case v1alpha1.QueryParamMatchRegularExpression:
+			regular = true
+		default:
+			return fmt.Sprintf("cannot evaluate %s query parameter match", qm.Type)
+		}
+
+		var names []string
+		for name := range qm.Values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var params []string
+		for _, name := range names {
+			value := qm.Values[name]
+			actual, present := req.query[name]
+			if !present {
+				return fmt.Sprintf("query parameter %s is missing", name)
+			}
+			if regular {
+				matched, err := matchRegularExpression(value, actual[0])
+				if err != nil {
+					return fmt.Sprintf("cannot evaluate query parameter regular expression %q: %v", value, err)
+				}
+				if !matched {
+					return fmt.Sprintf("query parameter %s does not match regular expression %q", name, value)
+				}
+			} else if actual[0] != value {
+				return fmt.Sprintf("query parameter %s is not %q", name, value)
+			}
+			params = append(params, fmt.Sprintf("%s=%s", name, value))
+		}
+		m.queryParams = len(params)
+		descriptions = append(descriptions, "query "+strings.Join(params, "&"))
+	}
+
+	if ref := hm.ExtensionRef; ref != nil {
+		return fmt.Sprintf("cannot evaluate extensionRef %s %s", ref.Kind, ref.Name)
+	}
+
+	m.description = strings.Join(descriptions, ", ")
+	return ""
+}
+
+// matchGRPCRoute matches the request against the hostnames and then the
+// rules of the route. The service and method of a gRPC request are taken
+// from its path, "/SERVICE/METHOD". A rule without matches selects every
+// request.
+//
+// Method matches are ranked like the equivalent path matches, so that
+// GRPCRoutes and HTTPRoutes bound to the same listener are ordered
+// consistently: a service and method is an Exact path match, a service
+// alone is a Prefix path match and anything else is a regular expression.
+func (r *Result) matchGRPCRoute(route *topology.Route, obj *v1alpha1.GRPCRoute, req *Request) []*match {
+	hostname, ok := routeHostname(route.Hostnames, req.Host)
+	if !ok {
+		r.reject(routeName(route), fmt.Sprintf("host %q does not match hostnames %s", req.Host, strings.Join(route.Hostnames, ", ")))
+		return nil
+	}
+
+	service, method := grpcMethod(req.Path)
+
+	var matches []*match
+	for i, rule := range obj.Spec.Rules {
+		if len(rule.Matches) == 0 {
+			matches = append(matches, &match{
+				route:       route,
+				rule:        i,
+				index:       -1,
+				description: "any request",
+				hostname:    hostname,
+				path:        pathPrefix,
+				pathLen:     1,
+			})
+			continue
+		}
+
+		for j, m := range rule.Matches {
+			candidate := &match{route: route, rule: i, index: j, hostname: hostname}
+			if reason := candidate.matchGRPC(m, service, method, req); reason != "" {
+				r.reject(candidate.name(), reason)
+				continue
+			}
+			matches = append(matches, candidate)
+		}
+	}
+	return matches
+}
+
+// matchGRPC evaluates a single GRPCRouteMatch. It returns why the match
+// does not select the request, or the empty string if it does.
+func (m *match) matchGRPC(gm v1alpha1.GRPCRouteMatch, service, method string, req *Request) string {
+	m.path, m.pathLen = pathPrefix, 1
+	var descriptions []string
+
+	if mm := gm.Method; mm != nil {
+		conditions := []struct {
+			name     string
+			expected *string
+			actual   string
+		}{
+			{"service", mm.Service, service},
+			{"method", mm.Method, method},
+		}
+
+		regular := false
+		switch mm.Type {
+		case "", v1alpha1.GRPCMethodMatchExact:
+		case v1alpha1.GRPCMethodMatchRegularExpression:
+			regular = true
+		default:
+			return fmt.Sprintf("cannot evaluate %s method match", mm.Type)
+		}
+
+		for _, c := range conditions {
+			if c.expected == nil {
+				continue
+			}
+			if regular {
+				matched, err := matchRegularExpression(*c.expected, c.actual)
+				if err != nil {
+					return fmt.Sprintf("cannot evaluate %s regular expression %q: %v", c.name, *c.expected, err)
+				}
+				if !matched {
+					return fmt.Sprintf("%s %q does not match regular expression %q", c.name, c.actual, *c.expected)
+				}
+				descriptions = append(descriptions, fmt.Sprintf("%s RegularExpression %q", c.name, *c.expected))
+			} else {
+				if c.actual != *c.expected {
+					return fmt.Sprintf("%s %q is not %q", c.name, c.actual, *c.expected)
+				}
+				descriptions = append(descriptions, fmt.Sprintf("%s %q", c.name, *c.expected))
+			}
+		}
+
+		switch {
+		case regular || mm.Service == nil:
+			m.path = pathRegularExpression
+		case mm.Method == nil:
+			m.pathLen = len("/" + *mm.Service + "/")
+		default:
+			m.path = pathExact
+			m.pathLen = len("/" + *mm.Service + "/" + *mm.Method)
+		}
+	}
+
+	if len(gm.Headers) > 0 {
+		headers, reason := matchHeaders(v1alpha1.HTTPHeaderMatch{Matchers: gm.Headers}, req.Headers)
+		if reason != "" {
+			return reason
+		}
+		m.headers = len(headers)
+		descriptions = append(descriptions, "headers "+strings.Join(headers, ","))
+	}
+
+	m.description = "any request"
+	if len(descriptions) > 0 {
+		m.description = strings.Join(descriptions, ", ")
+	}
+	return ""
+}
+
+// grpcMethod returns the service and method of a gRPC request path. Both
+// are empty if the path is not of the form "/SERVICE/METHOD".
+func grpcMethod(path string) (string, string) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 || parts[0] != "" || parts[1] == "" || parts[2] == "" {
+		return "", ""
+	}
+	return parts[1], parts[2]
+}
+
+// matchTLSRoute matches the SNI, destination port and ALPN protocols of
+// the request against the rules of the route. A rule without matches, or
+// a match without conditions, selects every connection.
+func (r *Result) matchTLSRoute(route *topology.Route, obj *v1alpha1.TLSRoute, req *Request) []*match {
+	var matches []*match
+	for i, rule := range obj.Spec.Rules {
+		if len(rule.Matches) == 0 {
+			matches = append(matches, &match{route: route, rule: i, index: -1, description: "any connection"})
+			continue
+		}
+
+		for j, m := range rule.Matches {
+			candidate := &match{route: route, rule: i, index: j}
+			if reason := matchTLS(candidate, m, req); reason != "" {
+				r.reject(candidate.name(), reason)
+				continue
+			}
+			matches = append(matches, candidate)
+		}
+	}
+	return matches
+}
+
+// matchTLS evaluates a single TLSRoute match. It fills in the specificity
+// and description of m and returns why the match does not select the
+// connection, or "" if it does.
+func matchTLS(m *match, tm v1alpha1.TLSRouteMatch, req *Request) string {
+	if ref := tm.ExtensionRef; ref != nil {
+		return fmt.Sprintf("cannot evaluate extensionRef %s %s", ref.Kind, ref.Name)
+	}
+
+	var descriptions []string
+
+	if len(tm.SNIs) > 0 {
+		sni := req.serverName()
+		hostname, ok := routeHostname(tm.SNIs, sni)
+		if !ok {
+			return fmt.Sprintf("SNI %q does not match %s", sni, strings.Join(tm.SNIs, ", "))
+		}
+		m.hostname = hostname
+		descriptions = append(descriptions, "SNI "+strings.Join(tm.SNIs, ", "))
+	}
+
+	if tm.Port != nil {
+		if *tm.Port != req.Port {
+			return fmt.Sprintf("port %d does not match %d", req.Port, *tm.Port)
+		}
+		m.port = true
+		descriptions = append(descriptions, fmt.Sprintf("port %d", *tm.Port))
+	}
+
+	if len(tm.ALPNProtocols) > 0 {
+		proto, ok := offeredProtocol(tm.ALPNProtocols, req.ALPN)
+		if !ok {
+			offered := "no ALPN protocols"
+			if len(req.ALPN) > 0 {
+				offered = "ALPN " + strings.Join(req.ALPN, ", ")
+			}
+			return fmt.Sprintf("%s does not match %s", offered, strings.Join(tm.ALPNProtocols, ", "))
+		}
+		m.alpn = true
+		descriptions = append(descriptions, "ALPN "+proto)
+	}
+
+	m.description = "any connection"
+	if len(descriptions) > 0 {
+		m.description = strings.Join(descriptions, ", ")
+	}
+	return ""
+}
+
+// offeredProtocol returns the first of the offered ALPN protocols that is
+// one of the accepted protocols, following the client's preference order.
+func offeredProtocol(accepted, offered []string) (string, bool) {
+	for _, proto := range offered {
+		for _, a := range accepted {
+			if proto == a {
+				return proto, true
+			}
+		}
+	}
+	return "", false
+}
+
+// l4Match holds the conditions of a TCPRoute or UDPRoute match.
+type l4Match struct {
+	sourceCIDRs  []string
+	port         *int32
+	extensionRef *v1alpha1.LocalObjectReference
+}
+
+// matchL4Route matches the source address and destination port of the
+// request against TCPRoute and UDPRoute rules, given the matches of each
+// rule. A rule without matches, or a match without conditions, selects
+// every connection. Implementation-specific extensions are not evaluated.
+func (r *Result) matchL4Route(route *topology.Route, rules [][]l4Match, req *Request) []*match {
+	var matches []*match
+	for i, ruleMatches := range rules {
+		if len(ruleMatches) == 0 {
+			matches = append(matches, &match{route: route, rule: i, index: -1, description: "any connection"})
+			continue
+		}
+
+		for j, m := range ruleMatches {
+			candidate := &match{route: route, rule: i, index: j}
+			if reason := matchL4(candidate, m, req); reason != "" {
+				r.reject(candidate.name(), reason)
+				continue
+			}
+			matches = append(matches, candidate)
+		}
+	}
+	return matches
+}
+
+// matchL4 evaluates a single TCPRoute or UDPRoute match. It fills in the
+// specificity and description of m and returns why the match does not
+// select the connection, or "" if it does.
+func matchL4(m *match, lm l4Match, req *Request) string {
+	if ref := lm.extensionRef; ref != nil {
+		return fmt.Sprintf("cannot evaluate extensionRef %s %s", ref.Kind, ref.Name)
+	}
+
+	var descriptions []string
+
+	if len(lm.sourceCIDRs) > 0 {
+		if req.Source == nil {
+			return fmt.Sprintf("source address is unknown and does not match %s", strings.Join(lm.sourceCIDRs, ", "))
+		}
+		cidr, prefix, ok := sourceCIDR(lm.sourceCIDRs, req.Source)
+		if !ok {
+			return fmt.Sprintf("source %s does not match %s", req.Source, strings.Join(lm.sourceCIDRs, ", "))
+		}
+		m.source = prefix + 1
+		descriptions = append(descriptions, "source "+cidr)
+	}
+
+	if lm.port != nil {
+		if *lm.port != req.Port {
+			return fmt.Sprintf("port %d does not match %d", req.Port, *lm.port)
+		}
+		m.port = true
+		descriptions = append(descriptions, fmt.Sprintf("port %d", *lm.port))
+	}
+
+	m.description = "any connection"
+	if len(descriptions) > 0 {
+		m.description = strings.Join(descriptions, ", ")
+	}
+	return ""
+}
+
+// sourceCIDR returns the longest of the CIDR blocks that contains the
+// address, and its prefix length. Blocks that do not parse are ignored.
+func sourceCIDR(cidrs []string, ip net.IP) (string, int, bool) {
+	best, bestPrefix := "", -1
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil || !network.Contains(ip) {
+			continue
+		}
+		if prefix, _ := network.Mask.Size(); prefix > bestPrefix {
+			best, bestPrefix = cidr, prefix
+		}
+	}
+	return best, bestPrefix, bestPrefix >= 0
+}
+
+// matchHeaders evaluates the Values map and the Matchers of a header
+// match. It returns a description of each matched condition, or why the
+// match does not select the request.
+func matchHeaders(hm v1alpha1.HTTPHeaderMatch, headers http.Header) ([]string, string) {
+	var names []string
+	for name := range hm.Values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var matched []string
+	for _, name := range names {
+		matcher := v1alpha1.HTTPHeaderMatcher{Name: name, Type: hm.Type, Value: hm.Values[name]}
+		if reason := matchHeader(matcher, headers); reason != "" {
+			return nil, reason
+		}
+		matched = append(matched, describeHeaderMatcher(matcher))
+	}
+
+	for _, matcher := range hm.Matchers {
+		if reason := matchHeader(matcher, headers); reason != "" {
+			return nil, reason
+		}
+		matched = append(matched, describeHeaderMatcher(matcher))
+	}
+
+	return matched, ""
+}
+
+// matchHeader evaluates a single header condition. It returns why the
+// condition does not select the request, or the empty string if it does.
+func matchHeader(matcher v1alpha1.HTTPHeaderMatcher, headers http.Header) string {
+	values := headers[http.CanonicalHeaderKey(matcher.Name)]
+
+	switch matcher.Type {
+	case "", v1alpha1.HeaderMatchExact:
+		for _, v := range values {
+			if v == matcher.Value {
+				return ""
+			}
+		}
+		return fmt.Sprintf("header %s is not %q", matcher.Name, matcher.Value)
+	case v1alpha1.HeaderMatchRegularExpression:
+		for _, v := range values {
+			ok, err := matchRegularExpression(matcher.Value, v)
+			if err != nil {
+				return fmt.Sprintf("cannot evaluate header regular expression %q: %v", matcher.Value, err)
+			}
+			if ok {
+				return ""
+			}
+		}
+		return fmt.Sprintf("header %s does not match regular expression %q", matcher.Name, matcher.Value)
+	case v1alpha1.HeaderMatchPresent:
+		if len(values) == 0 {
+			return fmt.Sprintf("header %s is missing", matcher.Name)
+		}
+		return ""
+	case v1alpha1.HeaderMatchAbsent:
+		if len(values) > 0 {
+			return fmt.Sprintf("header %s is present", matcher.Name)
+		}
+		return ""
+	default:
+		return fmt.Sprintf("cannot evaluate %s header match", matcher.Type)
+	}
+}
+
+func describeHeaderMatcher(matcher v1alpha1.HTTPHeaderMatcher) string {
+	switch matcher.Type {
+	case v1alpha1.HeaderMatchRegularExpression:
+		return fmt.Sprintf("%s~%s", matcher.Name, matcher.Value)
+	case v1alpha1.HeaderMatchPresent:
+		return matcher.Name + " present"
+	case v1alpha1.HeaderMatchAbsent:
+		return matcher.Name + " absent"
+	default:
+		return fmt.Sprintf("%s=%s", matcher.Name, matcher.Value)
+	}
+}
+
+// matchRegularExpression reports whether the whole of s matches the
+// regular expression, in the RE2 dialect of Go.
+func matchRegularExpression(expr, s string) (bool, error) {
+	re, err := regexp.Compile("^(?:" + expr + ")$")
+	if err != nil {
+		return false, err
+	}
+	return re.MatchString(s), nil
+}
+
+func methodMatchType(t v1alpha1.MethodMatchType) v1alpha1.MethodMatchType {
+	if t == "" {
+		return v1alpha1.MethodMatchExact
+	}
+	return t
+}
+
+// routeHostname returns the specificity of the most specific hostname
+// that matches host, and whether any does. An empty list matches every
+// host.
+func routeHostname(hostnames []string, host string) (int, bool) {
+	if len(hostnames) == 0 {
+		return hostnameAny, true
+	}
+
+	best, found := hostnameAny, false
+	for _, h := range hostnames {
+		if !topology.RouteMatchesHostname(h, host) {
+			continue
+		}
+		found = true
+		if strings.HasPrefix(h, "*.") {
+			if best < hostnameWildcard {
+				best = hostnameWildcard
+			}
+		} else {
+			best = hostnamePrecise
+		}
+	}
+	return best, found
+}
+
+// pathHasPrefix reports whether path has the given prefix, compared
+// element by element: "/foo" matches "/foo" and "/foo/bar" but not
+// "/foobar".
+func pathHasPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		return true
+	}
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
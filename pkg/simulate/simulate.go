@@ -0,0 +1,419 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulate determines which listener, route, rule and backends
+// would serve a request, following the binding, hostname and matching
+// semantics defined by the service-apis types.
+package simulate
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/topology"
+)
+
+// Request describes a client request or connection.
+type Request struct {
+	// Protocol is the transport protocol of the connection, TCP or UDP.
+	// It defaults to TCP.
+	Protocol v1alpha1.ProtocolType
+
+	// Port is the destination port of the connection.
+	Port int32
+
+	// Source is the client source address of the connection. Matches
+	// on source CIDR blocks never select a request without one.
+	Source net.IP
+
+	// Host is the HTTP host of the request. It may include a port, which
+	// hostname matching ignores.
+	Host string
+
+	// SNI is the server name offered in the TLS ClientHello. If empty,
+	// Host is offered.
+	SNI string
+
+	// ALPN are the application protocols offered in the TLS ClientHello.
+	ALPN []string
+
+	// Method is the HTTP request method. It defaults to GET.
+	Method string
+
+	// Path is the HTTP request path. It may include a query string.
+	Path string
+
+	// Headers are the HTTP request headers.
+	Headers http.Header
+
+	// query holds the query parameters parsed from Path, and rawQuery
+	// the query string they were parsed from.
+	query    url.Values
+	rawQuery string
+}
+
+func (r *Request) serverName() string {
+	if r.SNI != "" {
+		return r.SNI
+	}
+	return r.Host
+}
+
+// Candidate is a listener, route or route match that did not serve the
+// request.
+type Candidate struct {
+	// Name identifies the candidate.
+	Name string
+
+	// Reason explains why the candidate did not serve the request.
+	Reason string
+}
+
+// WeightedBackend is a backend that receives a share of the requests.
+type WeightedBackend struct {
+	topology.ResolvedBackend
+
+	// Share is the fraction of requests forwarded to the backend, in
+	// the range [0, 1].
+	Share float64
+}
+
+// Redirect is the response of a RequestRedirect filter.
+type Redirect struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int32
+
+	// Location is the value of the Location header of the response.
+	Location string
+}
+
+// Result describes how a request would be served.
+type Result struct {
+	// Gateway and Listener identify the listener that accepts the
+	// request. Gateway is nil if no listener accepts it.
+	Gateway       *v1alpha1.Gateway
+	ListenerIndex int
+	Listener      v1alpha1.Listener
+
+	// Route is the route that serves the request, or nil if none does.
+	Route *topology.Route
+
+	// RuleIndex is the index of the chosen rule in the route.
+	RuleIndex int
+
+	// Match describes the match of the rule that selected the request.
+	Match string
+
+	// Redirect is the response of the RequestRedirect filter of the
+	// chosen rule, or nil if the rule has none. A redirected request is
+	// not forwarded, so Backends is then empty.
+	Redirect *Redirect
+
+	// Backends are the forwarding targets of the chosen rule.
+	Backends []WeightedBackend
+
+	// Rejected lists every listener, route and match that was
+	// considered and not chosen, in evaluation order.
+	Rejected []Candidate
+}
+
+// Simulate evaluates the request against the Gateways in the snapshot.
+// If gateway is non-nil, only that Gateway is considered. An error is
+// returned if the request could be accepted by more than one Gateway,
+// because the Gateways would then have to be told apart by address.
+func Simulate(res *topology.Resources, gateway *v1alpha1.Gateway, req Request) (*Result, error) {
+	if i := strings.Index(req.Path, "#"); i >= 0 {
+		req.Path = req.Path[:i]
+	}
+	if i := strings.Index(req.Path, "?"); i >= 0 {
+		query, err := url.ParseQuery(req.Path[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid query string: %w", err)
+		}
+		req.Path, req.query, req.rawQuery = req.Path[:i], query, req.Path[i+1:]
+	}
+	switch req.Protocol {
+	case "":
+		req.Protocol = v1alpha1.TCPProtocolType
+	case v1alpha1.TCPProtocolType, v1alpha1.UDPProtocolType:
+	default:
+		return nil, fmt.Errorf("unsupported protocol %q, expected TCP or UDP", req.Protocol)
+	}
+	req.Host = hostname(req.Host)
+	if req.Method == "" {
+		req.Method = http.MethodGet
+	}
+	if req.Path == "" {
+		req.Path = "/"
+	}
+
+	result := &Result{}
+
+	gateways := []*v1alpha1.Gateway{gateway}
+	if gateway == nil {
+		gateways = nil
+		for i := range res.Gateways {
+			gateways = append(gateways, &res.Gateways[i])
+		}
+	}
+
+	type choice struct {
+		gw    *v1alpha1.Gateway
+		index int
+	}
+	var chosen []choice
+
+	for _, gw := range gateways {
+		best := -1
+		for i, l := range gw.Spec.Listeners {
+			name := fmt.Sprintf("Gateway %s/%s listener %d", gw.Namespace, gw.Name, i)
+			if reason := listenerMismatch(l, &req); reason != "" {
+				result.reject(name, reason)
+				continue
+			}
+			if best >= 0 {
+				rank, bestRank := hostnameRank(l.Hostname), hostnameRank(gw.Spec.Listeners[best].Hostname)
+				if rank < bestRank {
+					result.reject(name, fmt.Sprintf("listener %d has a more specific hostname", best))
+					continue
+				}
+				if rank == bestRank {
+					result.reject(name, fmt.Sprintf("listener %d has an equally specific hostname and comes first", best))
+					continue
+				}
+				result.reject(fmt.Sprintf("Gateway %s/%s listener %d", gw.Namespace, gw.Name, best),
+					fmt.Sprintf("listener %d has a more specific hostname", i))
+			}
+			best = i
+		}
+		if best >= 0 {
+			chosen = append(chosen, choice{gw, best})
+		}
+	}
+
+	switch len(chosen) {
+	case 0:
+		return result, nil
+	case 1:
+	default:
+		var names []string
+		for _, c := range chosen {
+			names = append(names, c.gw.Namespace+"/"+c.gw.Name)
+		}
+		return nil, fmt.Errorf("the request is accepted by listeners of several gateways (%s); select one gateway", strings.Join(names, ", "))
+	}
+
+	result.Gateway = chosen[0].gw
+	result.ListenerIndex = chosen[0].index
+	result.Listener = result.Gateway.Spec.Listeners[result.ListenerIndex]
+
+	lb := res.Bind(result.Gateway).Listeners[result.ListenerIndex]
+	for _, rej := range lb.Rejected {
+		result.reject(routeName(rej.Route), fmt.Sprintf("not bound to the listener: %s: %s", rej.Reason, rej.Message))
+	}
+
+	var matches []*match
+	for _, route := range sortRoutes(lb.Routes) {
+		matches = append(matches, result.matchRoute(route, &req, result.Listener.Protocol)...)
+	}
+
+	if len(matches) == 0 {
+		return result, nil
+	}
+
+	// Matches are ordered by precedence, so the first one wins.
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].precedes(matches[j])
+	})
+
+	winner := matches[0]
+	for _, m := range matches[1:] {
+		result.reject(m.name(), fmt.Sprintf("matches, but %s takes precedence", winner.name()))
+	}
+
+	result.Route = winner.route
+	result.RuleIndex = winner.rule
+	result.Match = winner.description
+	if hr, ok := winner.route.Object.(*v1alpha1.HTTPRoute); ok {
+		for _, f := range hr.Spec.Rules[winner.rule].Filters {
+			if f.Type == v1alpha1.FilterTypeHTTPRequestRedirect && f.RequestRedirect != nil {
+				result.Redirect = redirect(f.RequestRedirect, &req, result.Listener.Protocol)
+				return result, nil
+			}
+		}
+	}
+	result.Backends = weighBackends(res, winner.route, winner.route.Rules[winner.rule].ForwardTo)
+
+	return result, nil
+}
+
+func (r *Result) reject(name, reason string) {
+	r.Rejected = append(r.Rejected, Candidate{Name: name, Reason: reason})
+}
+
+// wellKnownPorts are the ports that a Location header leaves out for each
+// scheme.
+var wellKnownPorts = map[string]int32{"http": 80, "https": 443}
+
+// redirect returns the response of filter to req, which a listener of
+// protocol accepted. Like the filter, it takes the fields that are not
+// specified from the request.
+func redirect(filter *v1alpha1.HTTPRequestRedirectFilter, req *Request, protocol v1alpha1.ProtocolType) *Redirect {
+	r := &Redirect{StatusCode: http.StatusFound}
+	if filter.StatusCode != nil {
+		r.StatusCode = *filter.StatusCode
+	}
+
+	scheme, port := "http", req.Port
+	if protocol == v1alpha1.HTTPSProtocolType {
+		scheme = "https"
+	}
+	if filter.Scheme != nil {
+		scheme = strings.ToLower(*filter.Scheme)
+		port = wellKnownPorts[scheme]
+	}
+	if filter.Port != nil {
+		port = *filter.Port
+	}
+
+	host := req.Host
+	if filter.Hostname != nil {
+		host = *filter.Hostname
+	}
+	if port != wellKnownPorts[scheme] {
+		host = net.JoinHostPort(host, fmt.Sprint(port))
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	path := req.Path
+	if filter.Path != nil {
+		path = *filter.Path
+	}
+
+	u := url.URL{Scheme: scheme, Host: host, Path: path, RawQuery: req.rawQuery}
+	r.Location = u.String()
+	return r
+}
+
+// hostname returns host without its port, if it has one.
+func hostname(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+}
+
+// transports are the transport protocols of the core listener
+// protocols.
+var transports = map[v1alpha1.ProtocolType]v1alpha1.ProtocolType{
+	v1alpha1.HTTPProtocolType:  v1alpha1.TCPProtocolType,
+	v1alpha1.HTTPSProtocolType: v1alpha1.TCPProtocolType,
+	v1alpha1.TLSProtocolType:   v1alpha1.TCPProtocolType,
+	v1alpha1.TCPProtocolType:   v1alpha1.TCPProtocolType,
+	v1alpha1.UDPProtocolType:   v1alpha1.UDPProtocolType,
+}
+
+// listenerMismatch returns why the listener does not accept the request,
+// or the empty string if it does.
+func listenerMismatch(l v1alpha1.Listener, req *Request) string {
+	if l.Port != req.Port {
+		return fmt.Sprintf("listens on port %d", l.Port)
+	}
+	if transport, ok := transports[l.Protocol]; ok && transport != req.Protocol {
+		return fmt.Sprintf("listens on %s port %d", transport, l.Port)
+	}
+
+	var name, what string
+	switch l.Protocol {
+	case v1alpha1.HTTPProtocolType:
+		name, what = req.Host, "host"
+	case v1alpha1.HTTPSProtocolType, v1alpha1.TLSProtocolType:
+		name, what = req.serverName(), "SNI"
+	default:
+		return ""
+	}
+
+	if !topology.ListenerMatchesHostname(l.Hostname, name) {
+		return fmt.Sprintf("%s %q does not match listener hostname %s %q", what, name, l.Hostname.Match, l.Hostname.Name)
+	}
+	return ""
+}
+
+// hostnameRank orders listener hostname matches from least to most
+// specific.
+func hostnameRank(m v1alpha1.HostnameMatch) int {
+	switch {
+	case m.Match == v1alpha1.HostnameMatchDomain:
+		return 1
+	case m.Match == v1alpha1.HostnameMatchExact, m.Match == "" && m.Name != "":
+		return 2
+	default:
+		return 0
+	}
+}
+
+// sortRoutes orders routes by creation timestamp and then by
+// namespace/name, which are the tie-breakers for equally specific
+// matches.
+func sortRoutes(routes []*topology.Route) []*topology.Route {
+	sorted := append([]*topology.Route(nil), routes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return sorted
+}
+
+func routeName(route *topology.Route) string {
+	return fmt.Sprintf("%s %s/%s", route.Kind, route.Namespace, route.Name)
+}
+
+// weighBackends computes the share of requests forwarded to each backend.
+// Weights default to 1.
+func weighBackends(res *topology.Resources, route *topology.Route, backends []topology.Backend) []WeightedBackend {
+	var total int32
+	for _, b := range backends {
+		total += weight(b)
+	}
+
+	var weighted []WeightedBackend
+	for _, b := range backends {
+		weighted = append(weighted, WeightedBackend{
+			ResolvedBackend: res.ResolveBackend(route, b),
+			Share:           float64(weight(b)) / float64(total),
+		})
+	}
+	return weighted
+}
+
+func weight(b topology.Backend) int32 {
+	if b.Weight == 0 {
+		return 1
+	}
+	return b.Weight
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulate
+
+import (
+	"net"
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/builders"
+	"sigs.k8s.io/service-apis/pkg/manifest"
+	"sigs.k8s.io/service-apis/pkg/topology"
+)
+
+func TestSimulate(t *testing.T) {
+	objects, err := manifest.Load("testdata/routes.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := &topology.Resources{}
+	for _, o := range objects {
+		res.Add(o.Object)
+	}
+
+	tests := []struct {
+		name     string
+		req      Request
+		listener int
+		route    string
+		rule     int
+		shares   []float64
+	}{
+		{
+			name:     "precise hostname takes precedence",
+			req:      Request{Port: 80, Host: "www.example.com", Path: "/api/users", Headers: http.Header{"Version": {"2"}}},
+			listener: 1,
+			route:    "team-b/canary",
+			shares:   []float64{1},
+		},
+		{
+			name:     "header mismatch falls back to wildcard hostname",
+			req:      Request{Port: 80, Host: "www.example.com", Path: "/api/users?debug=1"},
+			listener: 1,
+			route:    "team-a/web",
+			shares:   []float64{0.9, 0.1},
+		},
+		{
+			name:     "host with port",
+			req:      Request{Port: 80, Host: "www.example.com:80", Path: "/api/users"},
+			listener: 1,
+			route:    "team-a/web",
+			shares:   []float64{0.9, 0.1},
+		},
+		{
+			name:     "prefix matches whole path elements",
+			req:      Request{Port: 80, Host: "shop.example.com", Path: "/apix"},
+			listener: 0,
+			route:    "team-a/web",
+			rule:     1,
+			shares:   []float64{1},
+		},
+		{
+			name:   "method and query parameters",
+			req:    Request{Port: 80, Host: "orders.example.com", Method: "POST", Path: "/orders/1?version=beta"},
+			route:  "team-c/orders",
+			shares: []float64{1},
+		},
+		{
+			name:   "method mismatch",
+			req:    Request{Port: 80, Host: "orders.example.com", Path: "/orders/1?version=beta"},
+			route:  "team-c/orders",
+			rule:   1,
+			shares: []float64{1},
+		},
+		{
+			name:   "header matchers",
+			req:    Request{Port: 80, Host: "m.example.com", Headers: http.Header{"User-Agent": {"Mozilla/5.0 (iPhone)"}}},
+			route:  "team-c/mobile",
+			shares: []float64{1},
+		},
+		{
+			name:   "header matcher for absent header",
+			req:    Request{Port: 80, Host: "m.example.com", Headers: http.Header{"User-Agent": {"Mozilla/5.0 (iPhone)"}, "X-Debug": {"1"}}},
+			route:  "team-c/mobile",
+			rule:   1,
+			shares: []float64{1},
+		},
+		{
+			name:     "gRPC service and method",
+			req:      Request{Port: 50051, Method: "POST", Path: "/helloworld.Greeter/SayHello", Headers: http.Header{"Version": {"2"}}},
+			listener: 2,
+			route:    "team-d/greeter",
+			rule:     1,
+			shares:   []float64{1},
+		},
+		{
+			name:     "gRPC service",
+			req:      Request{Port: 50051, Method: "POST", Path: "/helloworld.Greeter/SayHello"},
+			listener: 2,
+			route:    "team-d/greeter",
+			shares:   []float64{1},
+		},
+		{
+			name:     "TLS ALPN protocol",
+			req:      Request{Port: 443, SNI: "secure.example.com", ALPN: []string{"h2", "http/1.1"}},
+			listener: 3,
+			route:    "team-e/secure",
+			shares:   []float64{1},
+		},
+		{
+			name:     "TLS ALPN mismatch",
+			req:      Request{Port: 443, SNI: "secure.example.com", ALPN: []string{"http/1.1"}},
+			listener: 3,
+			route:    "team-e/secure",
+			rule:     1,
+			shares:   []float64{1},
+		},
+		{
+			name:     "TLS port takes precedence over ALPN",
+			req:      Request{Port: 8443, SNI: "secure.example.com", ALPN: []string{"h2"}},
+			listener: 4,
+			route:    "team-e/secure",
+			rule:     2,
+			shares:   []float64{1},
+		},
+		{
+			name:     "longest source CIDR prefix takes precedence",
+			req:      Request{Port: 5432, Source: net.ParseIP("10.1.2.3")},
+			listener: 5,
+			route:    "team-e/database",
+			rule:     1,
+			shares:   []float64{1},
+		},
+		{
+			name:     "source CIDR",
+			req:      Request{Port: 5432, Source: net.ParseIP("10.2.0.1")},
+			listener: 5,
+			route:    "team-e/database",
+			shares:   []float64{1},
+		},
+		{
+			name:     "source CIDR mismatch",
+			req:      Request{Port: 5432, Source: net.ParseIP("192.0.2.1")},
+			listener: 5,
+			route:    "team-e/database",
+			rule:     2,
+			shares:   []float64{1},
+		},
+		{
+			name:     "no route for hostname",
+			req:      Request{Port: 80, Host: "example.org"},
+			listener: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := Simulate(res, nil, tc.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Gateway == nil || result.ListenerIndex != tc.listener {
+				t.Fatalf("got listener %d, want %d", result.ListenerIndex, tc.listener)
+			}
+
+			if tc.route == "" {
+				if result.Route != nil {
+					t.Fatalf("got route %s/%s, want none", result.Route.Namespace, result.Route.Name)
+				}
+				if len(result.Rejected) == 0 {
+					t.Errorf("expected rejected candidates")
+				}
+				return
+			}
+
+			if result.Route == nil {
+				t.Fatalf("got no route, rejected %v", result.Rejected)
+			}
+			if got := result.Route.Namespace + "/" + result.Route.Name; got != tc.route || result.RuleIndex != tc.rule {
+				t.Errorf("got %s rule %d, want %s rule %d", got, result.RuleIndex, tc.route, tc.rule)
+			}
+			if len(result.Backends) != len(tc.shares) {
+				t.Fatalf("got %d backends, want %d", len(result.Backends), len(tc.shares))
+			}
+			for i, b := range result.Backends {
+				if b.Share != tc.shares[i] {
+					t.Errorf("backend %d: got share %v, want %v", i, b.Share, tc.shares[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSimulateListenerOrder(t *testing.T) {
+	listener := v1alpha1.Listener{Protocol: v1alpha1.HTTPProtocolType, Port: 80}
+	exact := listener
+	exact.Hostname = v1alpha1.HostnameMatch{Match: v1alpha1.HostnameMatchExact, Name: "www.example.com"}
+	res := &topology.Resources{Gateways: []v1alpha1.Gateway{{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "gateway"},
+		Spec:       v1alpha1.GatewaySpec{Listeners: []v1alpha1.Listener{listener, listener, exact}},
+	}}}
+
+	result, err := Simulate(res, nil, Request{Port: 80, Host: "www.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ListenerIndex != 2 {
+		t.Fatalf("got listener %d, want 2", result.ListenerIndex)
+	}
+	want := []Candidate{
+		{Name: "Gateway infra/gateway listener 1", Reason: "listener 0 has an equally specific hostname and comes first"},
+		{Name: "Gateway infra/gateway listener 0", Reason: "listener 2 has a more specific hostname"},
+	}
+	if len(result.Rejected) < len(want) {
+		t.Fatalf("got rejected %v, want %v first", result.Rejected, want)
+	}
+	for i, c := range want {
+		if result.Rejected[i] != c {
+			t.Errorf("rejected %d: got %+v, want %+v", i, result.Rejected[i], c)
+		}
+	}
+}
+
+func TestSimulateProtocol(t *testing.T) {
+	res := &topology.Resources{}
+	res.Add(builders.NewGateway("apps", "tcp", "acme-lb").Listener(builders.Listener(v1alpha1.TCPProtocolType, 8080)).Build())
+	res.Add(builders.NewGateway("apps", "udp", "acme-lb").Listener(builders.Listener(v1alpha1.UDPProtocolType, 8080)).Build())
+	res.Add(builders.NewTCPRoute("apps", "tcp-app").Rule().ForwardTo(builders.Service("tcp-service", 8080)).Build())
+	res.Add(builders.NewUDPRoute("apps", "udp-app").Rule().ForwardTo(builders.Service("udp-service", 8080)).Build())
+
+	tests := []struct {
+		protocol v1alpha1.ProtocolType
+		gateway  string
+		route    string
+		rejected Candidate
+	}{
+		{
+			gateway:  "tcp",
+			route:    "tcp-app",
+			rejected: Candidate{Name: "Gateway apps/udp listener 0", Reason: "listens on UDP port 8080"},
+		},
+		{
+			protocol: v1alpha1.UDPProtocolType,
+			gateway:  "udp",
+			route:    "udp-app",
+			rejected: Candidate{Name: "Gateway apps/tcp listener 0", Reason: "listens on TCP port 8080"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.gateway, func(t *testing.T) {
+			result, err := Simulate(res, nil, Request{Protocol: tc.protocol, Port: 8080})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Gateway == nil || result.Gateway.Name != tc.gateway {
+				t.Fatalf("got gateway %v, want apps/%s", result.Gateway, tc.gateway)
+			}
+			if result.Route == nil || result.Route.Name != tc.route {
+				t.Fatalf("got route %v, want apps/%s", result.Route, tc.route)
+			}
+			if len(result.Rejected) == 0 || result.Rejected[0] != tc.rejected {
+				t.Errorf("got rejected %v, want %+v first", result.Rejected, tc.rejected)
+			}
+		})
+	}
+
+	if _, err := Simulate(res, nil, Request{Protocol: v1alpha1.HTTPProtocolType, Port: 8080}); err == nil {
+		t.Error("got no error for protocol HTTP")
+	}
+}
+
+func TestSimulateRedirect(t *testing.T) {
+	tests := []struct {
+		name     string
+		listener *builders.ListenerBuilder
+		filter   *builders.RedirectFilterBuilder
+		req      Request
+		want     Redirect
+	}{
+		{
+			name:     "hostname and path",
+			listener: builders.Listener(v1alpha1.HTTPSProtocolType, 8443),
+			filter:   builders.Redirect().Hostname("new.example.com").Path("/new").StatusCode(http.StatusMovedPermanently),
+			req:      Request{Port: 8443, Host: "www.example.com:8443", Path: "/old?a=1"},
+			want:     Redirect{StatusCode: http.StatusMovedPermanently, Location: "https://new.example.com:8443/new?a=1"},
+		},
+		{
+			name:     "scheme",
+			listener: builders.Listener(v1alpha1.HTTPProtocolType, 80),
+			filter:   builders.Redirect().Scheme("HTTPS"),
+			req:      Request{Port: 80, Host: "www.example.com", Path: "/old"},
+			want:     Redirect{StatusCode: http.StatusFound, Location: "https://www.example.com/old"},
+		},
+		{
+			name:     "IPv6 host",
+			listener: builders.Listener(v1alpha1.HTTPProtocolType, 80),
+			filter:   builders.Redirect().Path("/new"),
+			req:      Request{Port: 80, Host: "[2001:db8::1]", Path: "/old"},
+			want:     Redirect{StatusCode: http.StatusFound, Location: "http://[2001:db8::1]/new"},
+		},
+		{
+			name:     "IPv6 host with port",
+			listener: builders.Listener(v1alpha1.HTTPProtocolType, 8080),
+			filter:   builders.Redirect().Path("/new"),
+			req:      Request{Port: 8080, Host: "[2001:db8::1]:8080", Path: "/old"},
+			want:     Redirect{StatusCode: http.StatusFound, Location: "http://[2001:db8::1]:8080/new"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := &topology.Resources{}
+			res.Add(builders.NewGateway("web", "gateway", "acme-lb").Listener(tc.listener).Build())
+			res.Add(builders.NewHTTPRoute("web", "redirect").
+				Rule(builders.Match().PathPrefix("/")).
+				Filter(tc.filter).
+				Build())
+
+			result, err := Simulate(res, nil, tc.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Redirect == nil {
+				t.Fatalf("got no redirect, want %+v", tc.want)
+			}
+			if *result.Redirect != tc.want {
+				t.Errorf("got redirect %+v, want %+v", *result.Redirect, tc.want)
+			}
+			if len(result.Backends) != 0 {
+				t.Errorf("got backends %v, want none", result.Backends)
+			}
+		})
+	}
+}
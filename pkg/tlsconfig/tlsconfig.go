@@ -0,0 +1,204 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tlsconfig builds crypto/tls configurations from the typed TLS
+// parameters of Gateways and BackendPolicies.
+//
+// Certificates are referenced by name in the API and are not loaded by
+// this package; callers add them to the returned configuration and pass
+// in the CA certificates used to verify clients.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+var versions = map[v1alpha1.TLSVersion]uint16{
+	v1alpha1.TLSVersion10: tls.VersionTLS10,
+	v1alpha1.TLSVersion11: tls.VersionTLS11,
+	v1alpha1.TLSVersion12: tls.VersionTLS12,
+	v1alpha1.TLSVersion13: tls.VersionTLS13,
+}
+
+// Version returns the crypto/tls value of a TLS version.
+func Version(v v1alpha1.TLSVersion) (uint16, error) {
+	if id, ok := versions[v]; ok {
+		return id, nil
+	}
+	return 0, fmt.Errorf("unknown TLS version %q", v)
+}
+
+// ErrTLS13CipherSuite is returned by CipherSuite for the TLS 1.3 cipher
+// suites, which crypto/tls does not let a configuration choose.
+var ErrTLS13CipherSuite = errors.New("TLS 1.3 cipher suites are not configurable")
+
+// CipherSuite returns the ID of a cipher suite given its IANA name. Both
+// the secure and the insecure cipher suites of crypto/tls are known. The
+// TLS 1.3 cipher suites fail with ErrTLS13CipherSuite.
+func CipherSuite(name string) (uint16, error) {
+	for _, suites := range [][]*tls.CipherSuite{tls.CipherSuites(), tls.InsecureCipherSuites()} {
+		for _, s := range suites {
+			if s.Name != name {
+				continue
+			}
+			if len(s.SupportedVersions) == 1 && s.SupportedVersions[0] == tls.VersionTLS13 {
+				return 0, fmt.Errorf("cipher suite %q: %w", name, ErrTLS13CipherSuite)
+			}
+			return s.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown cipher suite %q", name)
+}
+
+// Build returns a configuration that negotiates the versions, cipher
+// suites and ALPN protocols of params. Unset parameters keep the
+// crypto/tls defaults.
+func Build(params v1alpha1.TLSParameters) (*tls.Config, error) {
+	config := &tls.Config{}
+
+	if params.MinVersion != nil {
+		v, err := Version(*params.MinVersion)
+		if err != nil {
+			return nil, err
+		}
+		config.MinVersion = v
+	}
+	if params.MaxVersion != nil {
+		v, err := Version(*params.MaxVersion)
+		if err != nil {
+			return nil, err
+		}
+		config.MaxVersion = v
+	}
+	if config.MinVersion != 0 && config.MaxVersion != 0 && config.MinVersion > config.MaxVersion {
+		return nil, fmt.Errorf("minimum TLS version %s is higher than maximum TLS version %s",
+			*params.MinVersion, *params.MaxVersion)
+	}
+
+	for _, name := range params.CipherSuites {
+		id, err := CipherSuite(name)
+		if err != nil {
+			return nil, err
+		}
+		config.CipherSuites = append(config.CipherSuites, id)
+	}
+
+	if len(params.ALPNProtocols) > 0 {
+		config.NextProtos = append([]string(nil), params.ALPNProtocols...)
+	}
+
+	return config, nil
+}
+
+// ForGateway returns the server configuration of a Gateway listener. If
+// the listener validates client certificates, clientCAs must hold the
+// certificates of its caCertificateRef, and the configuration rejects
+// clients whose certificate is not on the allow-lists.
+func ForGateway(c *v1alpha1.GatewayTLSConfig, clientCAs *x509.CertPool) (*tls.Config, error) {
+	config, err := Build(c.TLSParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ClientValidation == nil {
+		return config, nil
+	}
+	validation := *c.ClientValidation
+	if clientCAs == nil {
+		return nil, errors.New("client validation requires the certificates of caCertificateRef")
+	}
+
+	config.ClientCAs = clientCAs
+	config.ClientAuth = tls.RequireAndVerifyClientCert
+	if validation.Mode == v1alpha1.ClientValidationOptional {
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	if len(validation.SubjectAltNames) > 0 || len(validation.Subjects) > 0 {
+		// VerifyConnection, unlike VerifyPeerCertificate, also runs when
+		// a session is resumed.
+		config.VerifyConnection = func(cs tls.ConnectionState) error {
+			// VerifiedChains is empty if an optional certificate was
+			// not presented.
+			if len(cs.VerifiedChains) == 0 {
+				return nil
+			}
+			cert := cs.VerifiedChains[0][0]
+			if !clientAllowed(cert, validation) {
+				return fmt.Errorf("client certificate %q is not allowed", cert.Subject)
+			}
+			return nil
+		}
+	}
+
+	return config, nil
+}
+
+// clientAllowed reports whether the subject or a subject alternative name
+// of cert is on the allow-lists of validation.
+func clientAllowed(cert *x509.Certificate, validation v1alpha1.TLSClientValidation) bool {
+	subject := cert.Subject.String()
+	for _, s := range validation.Subjects {
+		if s == subject {
+			return true
+		}
+	}
+
+	var names []string
+	names = append(names, cert.DNSNames...)
+	names = append(names, cert.EmailAddresses...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		names = append(names, uri.String())
+	}
+	for _, allowed := range validation.SubjectAltNames {
+		for _, name := range names {
+			if name == allowed {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ClientIdentity returns the value of the identity header for a
+// connection, which is the subject of the verified client certificate. It
+// returns the empty string if the client did not present a certificate.
+func ClientIdentity(state tls.ConnectionState) string {
+	if len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return state.VerifiedChains[0][0].Subject.String()
+}
+
+// ForBackend returns the client configuration for connecting to the
+// backends of a BackendPolicy. serverName is the name used to verify the
+// backend certificate.
+func ForBackend(c *v1alpha1.BackendTLSConfig, serverName string) (*tls.Config, error) {
+	config, err := Build(c.TLSParameters)
+	if err != nil {
+		return nil, err
+	}
+	config.ServerName = serverName
+	return config, nil
+}
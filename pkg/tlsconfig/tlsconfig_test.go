@@ -0,0 +1,222 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsconfig
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+func TestBuild(t *testing.T) {
+	v12, v13 := v1alpha1.TLSVersion12, v1alpha1.TLSVersion13
+
+	config, err := Build(v1alpha1.TLSParameters{
+		MinVersion:    &v12,
+		MaxVersion:    &v13,
+		CipherSuites:  []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256"},
+		ALPNProtocols: []string{"h2", "http/1.1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.MinVersion != tls.VersionTLS12 || config.MaxVersion != tls.VersionTLS13 {
+		t.Errorf("got versions %x-%x, want %x-%x", config.MinVersion, config.MaxVersion, tls.VersionTLS12, tls.VersionTLS13)
+	}
+	wantSuites := []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256}
+	if !reflect.DeepEqual(config.CipherSuites, wantSuites) {
+		t.Errorf("got cipher suites %v, want %v", config.CipherSuites, wantSuites)
+	}
+	if !reflect.DeepEqual(config.NextProtos, []string{"h2", "http/1.1"}) {
+		t.Errorf("got ALPN protocols %v", config.NextProtos)
+	}
+
+	if _, err := Build(v1alpha1.TLSParameters{MinVersion: &v13, MaxVersion: &v12}); err == nil {
+		t.Errorf("expected an error for inverted versions")
+	}
+	if _, err := Build(v1alpha1.TLSParameters{CipherSuites: []string{"TLS_RSA_WITH_NULL_MD5"}}); err == nil {
+		t.Errorf("expected an error for an unknown cipher suite")
+	}
+	if _, err := Build(v1alpha1.TLSParameters{CipherSuites: []string{"TLS_AES_128_GCM_SHA256"}}); !errors.Is(err, ErrTLS13CipherSuite) {
+		t.Errorf("got error %v for a TLS 1.3 cipher suite, want %v", err, ErrTLS13CipherSuite)
+	}
+}
+
+func TestForGatewayClientValidation(t *testing.T) {
+	c := &v1alpha1.GatewayTLSConfig{
+		ClientValidation: &v1alpha1.TLSClientValidation{
+			Mode:            v1alpha1.ClientValidationOptional,
+			SubjectAltNames: []string{"spiffe://cluster.local/ns/default/sa/payments"},
+			Subjects:        []string{"CN=orders,O=Example Corp"},
+		},
+	}
+
+	if _, err := ForGateway(c, nil); err == nil {
+		t.Errorf("expected an error without client CAs")
+	}
+	config, err := ForGateway(c, x509.NewCertPool())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.ClientAuth != tls.VerifyClientCertIfGiven {
+		t.Errorf("got client auth %v, want %v", config.ClientAuth, tls.VerifyClientCertIfGiven)
+	}
+
+	payments, _ := url.Parse("spiffe://cluster.local/ns/default/sa/payments")
+	tests := []struct {
+		name string
+		cert *x509.Certificate
+		want bool
+	}{
+		{"allowed subject alternative name", &x509.Certificate{URIs: []*url.URL{payments}}, true},
+		{"allowed subject", &x509.Certificate{Subject: pkix.Name{CommonName: "orders", Organization: []string{"Example Corp"}}}, true},
+		{"unknown client", &x509.Certificate{Subject: pkix.Name{CommonName: "payments"}, DNSNames: []string{"payments"}}, false},
+	}
+	for _, tc := range tests {
+		err := config.VerifyConnection(tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{tc.cert}}})
+		if got := err == nil; got != tc.want {
+			t.Errorf("%s: got allowed %v, want %v", tc.name, got, tc.want)
+		}
+	}
+	if err := config.VerifyConnection(tls.ConnectionState{}); err != nil {
+		t.Errorf("optional certificate not presented: %v", err)
+	}
+}
+
+// issue returns a certificate for subject, signed by parent and its key,
+// or self-signed if parent is nil.
+func issue(t *testing.T, subject string, parent *tls.Certificate, uris ...*url.URL) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: subject},
+		DNSNames:     []string{subject},
+		URIs:         uris,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := template, crypto.Signer(key)
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.Leaf, parent.PrivateKey.(crypto.Signer)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, key.Public(), signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+// handshake connects client to server and returns whether the session
+// was resumed. The client reads a byte from the server, so that it
+// receives the session tickets sent after a TLS 1.3 handshake.
+func handshake(t *testing.T, client, server *tls.Config) (bool, error) {
+	t.Helper()
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		_, err = conn.Write([]byte{0})
+		serverErr <- err
+	}()
+
+	conn, err := tls.Dial("tcp", ln.Addr().String(), client)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+	_, err = conn.Read(make([]byte, 1))
+	if sErr := <-serverErr; sErr != nil {
+		return false, sErr
+	}
+	return conn.ConnectionState().DidResume, err
+}
+
+func TestForGatewayClientValidationOnResumption(t *testing.T) {
+	ca := issue(t, "ca", nil)
+	payments, _ := url.Parse("spiffe://cluster.local/ns/default/sa/payments")
+	clientCert := issue(t, "payments", &ca, payments)
+	serverCert := issue(t, "gateway.example.com", &ca)
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.Leaf)
+
+	// Both listeners share the session ticket keys, as the replicas of
+	// one listener would, but only the first allows the client.
+	var ticketKey [32]byte
+	listener := func(allowed string) *tls.Config {
+		config, err := ForGateway(&v1alpha1.GatewayTLSConfig{
+			ClientValidation: &v1alpha1.TLSClientValidation{SubjectAltNames: []string{allowed}},
+		}, pool)
+		if err != nil {
+			t.Fatal(err)
+		}
+		config.Certificates = []tls.Certificate{serverCert}
+		config.SetSessionTicketKeys([][32]byte{ticketKey})
+		return config
+	}
+	allowing := listener(payments.String())
+	denying := listener("spiffe://cluster.local/ns/default/sa/orders")
+
+	client := &tls.Config{
+		RootCAs:            pool,
+		ServerName:         "gateway.example.com",
+		Certificates:       []tls.Certificate{clientCert},
+		ClientSessionCache: tls.NewLRUClientSessionCache(1),
+	}
+	if _, err := handshake(t, client, allowing); err != nil {
+		t.Fatalf("allowed client: %v", err)
+	}
+	if resumed, err := handshake(t, client, allowing); err != nil || !resumed {
+		t.Fatalf("got resumed %v, error %v, want a resumed session", resumed, err)
+	}
+	if _, err := handshake(t, client, denying); err == nil {
+		t.Errorf("resumed session of a client that is not allowed was accepted")
+	}
+}
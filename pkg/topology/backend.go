@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// ResolvedBackend is the result of resolving a route forwarding target
+// against the snapshot.
+type ResolvedBackend struct {
+	Backend
+
+	// Namespace is the namespace the backend is looked up in.
+	Namespace string
+
+	// Port is the port traffic is forwarded to. It is zero if the port
+	// could not be determined.
+	Port int32
+
+	// Resolved is true if the backend exists and the port could be
+	// determined. Otherwise Message explains the failure.
+	Resolved bool
+	Message  string
+
+	// NotPermitted is true if the backend is in another namespace than
+	// the route, and no ReferenceGrant allows the reference.
+	NotPermitted bool
+}
+
+// String formats the backend as "kind/name:port", or as
+// "kind/namespace/name:port" if it sets a namespace.
+func (b Backend) String() string {
+	ns := ""
+	if b.Namespace != nil {
+		ns = *b.Namespace + "/"
+	}
+
+	name := "<none>"
+	switch {
+	case b.ServiceName != nil:
+		name = "Service/" + ns + *b.ServiceName
+	case b.BackendRef != nil:
+		name = b.BackendRef.Kind + "/" + ns + b.BackendRef.Name
+		if b.BackendRef.Group != "" {
+			name = b.BackendRef.Kind + "." + b.BackendRef.Group + "/" + ns + b.BackendRef.Name
+		}
+	}
+	if b.Port != nil {
+		name = fmt.Sprintf("%s:%d", name, *b.Port)
+	}
+	return name
+}
+
+// ResolveBackend looks up a forwarding target of a route. The target is
+// looked up in its own namespace if it sets one, and in the namespace of
+// the route otherwise; a target in another namespace must be allowed by a
+// ReferenceGrant. ServiceName takes precedence over BackendRef, as
+// specified by the API. Only Services can be resolved; other BackendRef
+// kinds are implementation-specific.
+func (r *Resources) ResolveBackend(route *Route, b Backend) ResolvedBackend {
+	namespace := route.Namespace
+	if b.Namespace != nil {
+		namespace = *b.Namespace
+	}
+	res := ResolvedBackend{Backend: b, Namespace: namespace}
+
+	target := Reference{Kind: "Service", Namespace: namespace}
+	switch {
+	case b.ServiceName != nil:
+		target.Name = *b.ServiceName
+	case b.BackendRef != nil:
+		target.Group, target.Kind, target.Name = b.BackendRef.Group, b.BackendRef.Kind, b.BackendRef.Name
+	default:
+		res.Message = "neither serviceName nor backendRef is set"
+		return res
+	}
+
+	from := Reference{Group: v1alpha1.GroupName, Kind: route.Kind, Namespace: route.Namespace, Name: route.Name}
+	if !r.ReferenceGranted(from, target) {
+		res.NotPermitted = true
+		res.Message = fmt.Sprintf("no ReferenceGrant in namespace %s allows references from %s in namespace %s",
+			namespace, route.Kind, route.Namespace)
+		if !r.Listed("ReferenceGrants") {
+			res.Message += " (ReferenceGrants could not be listed)"
+		}
+		return res
+	}
+
+	if !isServiceRef(target.Group, target.Kind) {
+		res.Message = fmt.Sprintf("backend kind %s is implementation-specific", target.Kind)
+		return res
+	}
+	name := target.Name
+
+	svc := r.Service(namespace, name)
+	if svc == nil {
+		if !r.Listed("Services") {
+			res.Message = fmt.Sprintf("service %s/%s is unknown: Services could not be listed", namespace, name)
+			return res
+		}
+		res.Message = fmt.Sprintf("service %s/%s not found", namespace, name)
+		return res
+	}
+
+	switch {
+	case b.Port != nil:
+		for _, p := range svc.Spec.Ports {
+			if p.Port == *b.Port {
+				res.Port = p.Port
+			}
+		}
+		if res.Port == 0 {
+			res.Message = fmt.Sprintf("service %s/%s has no port %d", namespace, name, *b.Port)
+			return res
+		}
+	case len(svc.Spec.Ports) == 1:
+		res.Port = svc.Spec.Ports[0].Port
+	default:
+		res.Message = fmt.Sprintf("service %s/%s has %d ports and no port is specified", namespace, name, len(svc.Spec.Ports))
+		return res
+	}
+
+	res.Resolved = true
+	return res
+}
+
+// isServiceRef reports whether a group and kind identify a core Service.
+// An empty kind defaults to Service, as for BackendPolicy BackendRefs.
+func isServiceRef(group, kind string) bool {
+	return (group == "" || group == "core") && (kind == "" || kind == "Service")
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// RejectReason explains why a Route is not bound to a Listener.
+type RejectReason string
+
+const (
+	// ReasonIncompatibleProtocol is used when the Listener selects a
+	// route kind that cannot serve the Listener's protocol.
+	ReasonIncompatibleProtocol RejectReason = "IncompatibleProtocol"
+
+	// ReasonNamespaceNotSelected is used when the route's namespace is
+	// not selected by the Listener's RouteNamespaces.
+	ReasonNamespaceNotSelected RejectReason = "NamespaceNotSelected"
+
+	// ReasonLabelsNotSelected is used when the route's labels are not
+	// selected by the Listener's RouteSelector.
+	ReasonLabelsNotSelected RejectReason = "LabelsNotSelected"
+
+	// ReasonGatewayNotAllowed is used when the route's Gateways field
+	// does not allow the Gateway to use the route.
+	ReasonGatewayNotAllowed RejectReason = "GatewayNotAllowed"
+
+	// ReasonHostnameMismatch is used when none of the route's hostnames
+	// can be accepted by the Listener's hostname match.
+	ReasonHostnameMismatch RejectReason = "HostnameMismatch"
+
+	// ReasonPortMismatch is used when none of the route's matches
+	// accepts connections on the Listener's port.
+	ReasonPortMismatch RejectReason = "PortMismatch"
+)
+
+// Rejection records a route that was a candidate for a Listener but was
+// not bound to it.
+type Rejection struct {
+	Route   *Route
+	Reason  RejectReason
+	Message string
+}
+
+// ListenerBinding holds the routes bound to a single Listener, and the
+// candidate routes that were rejected.
+type ListenerBinding struct {
+	// Index is the position of the Listener in the Gateway spec.
+	Index    int
+	Listener v1alpha1.Listener
+
+	// Routes are the routes bound to the Listener.
+	Routes []*Route
+
+	// Rejected are routes that either the Listener selects or that allow
+	// the Gateway, but not both.
+	Rejected []Rejection
+}
+
+// GatewayBinding holds the route bindings of every Listener of a Gateway.
+type GatewayBinding struct {
+	Gateway *v1alpha1.Gateway
+
+	// Class is the Gateway's GatewayClass, or nil if it is not part of
+	// the snapshot.
+	Class *v1alpha1.GatewayClass
+
+	Listeners []ListenerBinding
+}
+
+// Attachment describes the relation of a route with a single Listener.
+type Attachment struct {
+	Gateway       *v1alpha1.Gateway
+	ListenerIndex int
+	Listener      v1alpha1.Listener
+
+	// Bound is true if the route is bound to the Listener. Otherwise
+	// Reason and Message explain why it was rejected.
+	Bound   bool
+	Reason  RejectReason
+	Message string
+}
+
+// protocolRouteKinds lists the route kinds able to serve each Listener
+// protocol.
+var protocolRouteKinds = map[v1alpha1.ProtocolType][]string{
+	v1alpha1.HTTPProtocolType:  {KindHTTPRoute, KindGRPCRoute},
+	v1alpha1.HTTPSProtocolType: {KindHTTPRoute, KindGRPCRoute},
+	v1alpha1.TLSProtocolType:   {KindTLSRoute, KindTCPRoute},
+	v1alpha1.TCPProtocolType:   {KindTCPRoute},
+	v1alpha1.UDPProtocolType:   {KindUDPRoute},
+}
+
+// ProtocolSupportsKind reports whether routes of the given kind can serve
+// a Listener of the given protocol.
+func ProtocolSupportsKind(protocol v1alpha1.ProtocolType, kind string) bool {
+	for _, k := range protocolRouteKinds[protocol] {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// ListenerSelectsKind reports whether the Listener's route selector
+// selects routes of the given kind in the networking.x-k8s.io group.
+func ListenerSelectsKind(l *v1alpha1.Listener, kind string) bool {
+	group := l.Routes.Group
+	if group == "" {
+		group = v1alpha1.GroupName
+	}
+	return group == v1alpha1.GroupName && l.Routes.Kind == kind
+}
+
+// Bind evaluates every Listener of the Gateway against the routes in the
+// snapshot.
+func (r *Resources) Bind(gw *v1alpha1.Gateway) *GatewayBinding {
+	b := &GatewayBinding{
+		Gateway: gw,
+		Class:   r.GatewayClass(gw.Spec.GatewayClassName),
+	}
+
+	routes := r.Routes()
+	for i := range gw.Spec.Listeners {
+		b.Listeners = append(b.Listeners, r.bindListener(gw, i, routes))
+	}
+
+	return b
+}
+
+// Attachments returns the relation of the route with every Listener that
+// either selects the route or that the route allows.
+func (r *Resources) Attachments(route *Route) []Attachment {
+	var attachments []Attachment
+
+	routes := []*Route{route}
+	for i := range r.Gateways {
+		gw := &r.Gateways[i]
+		for j := range gw.Spec.Listeners {
+			lb := r.bindListener(gw, j, routes)
+			a := Attachment{
+				Gateway:       gw,
+				ListenerIndex: j,
+				Listener:      gw.Spec.Listeners[j],
+			}
+			switch {
+			case len(lb.Routes) > 0:
+				a.Bound = true
+			case len(lb.Rejected) > 0:
+				a.Reason = lb.Rejected[0].Reason
+				a.Message = lb.Rejected[0].Message
+			default:
+				continue
+			}
+			attachments = append(attachments, a)
+		}
+	}
+
+	return attachments
+}
+
+func (r *Resources) bindListener(gw *v1alpha1.Gateway, index int, routes []*Route) ListenerBinding {
+	l := &gw.Spec.Listeners[index]
+	lb := ListenerBinding{Index: index, Listener: *l}
+
+	for _, route := range routes {
+		if !ListenerSelectsKind(l, route.Kind) {
+			continue
+		}
+
+		nsSelected, nsMessage := r.namespaceSelected(gw, l, route)
+		labelsSelected, labelsMessage := labelsSelected(l, route)
+		allowed, allowMessage := RouteAllowsGateway(route, gw)
+
+		if !(nsSelected && labelsSelected) && !allowed {
+			// Neither side refers to the other, so this route is not
+			// interesting to report.
+			continue
+		}
+
+		var reason RejectReason
+		var message string
+
+		switch {
+		case !ProtocolSupportsKind(l.Protocol, route.Kind):
+			reason = ReasonIncompatibleProtocol
+			message = fmt.Sprintf("%s routes cannot serve a %s listener", route.Kind, l.Protocol)
+		case !nsSelected:
+			reason, message = ReasonNamespaceNotSelected, nsMessage
+		case !labelsSelected:
+			reason, message = ReasonLabelsNotSelected, labelsMessage
+		case !allowed:
+			reason, message = ReasonGatewayNotAllowed, allowMessage
+		case !HostnamesIntersect(l.Hostname, route.Hostnames):
+			reason = ReasonHostnameMismatch
+			message = fmt.Sprintf("no route hostname is accepted by listener hostname %s", describeHostnameMatch(l.Hostname))
+		case !routeAcceptsPort(route, l.Port):
+			reason = ReasonPortMismatch
+			message = fmt.Sprintf("no route match accepts listener port %d", l.Port)
+		}
+
+		if reason != "" {
+			lb.Rejected = append(lb.Rejected, Rejection{Route: route, Reason: reason, Message: message})
+		} else {
+			lb.Routes = append(lb.Routes, route)
+		}
+	}
+
+	return lb
+}
+
+// routeAcceptsPort reports whether any match of the route accepts
+// connections on the port.
+func routeAcceptsPort(route *Route, port int32) bool {
+	if len(route.Ports) == 0 {
+		return true
+	}
+	for _, p := range route.Ports {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+// namespaceSelected reports whether the Listener selects routes from the
+// route's namespace.
+func (r *Resources) namespaceSelected(gw *v1alpha1.Gateway, l *v1alpha1.Listener, route *Route) (bool, string) {
+	switch l.Routes.RouteNamespaces.From {
+	case v1alpha1.RouteSelectAll:
+		return true, ""
+	case v1alpha1.RouteSelectSelector:
+		selector, err := metav1.LabelSelectorAsSelector(&l.Routes.RouteNamespaces.Selector)
+		if err != nil {
+			return false, fmt.Sprintf("invalid namespace selector: %v", err)
+		}
+		var nsLabels labels.Set
+		if ns := r.Namespace(route.Namespace); ns != nil {
+			nsLabels = ns.Labels
+		} else if !r.Listed("Namespaces") {
+			return false, fmt.Sprintf("labels of namespace %q are unknown: Namespaces could not be listed", route.Namespace)
+		}
+		if !selector.Matches(nsLabels) {
+			return false, fmt.Sprintf("namespace %q does not match selector %q", route.Namespace, selector)
+		}
+		return true, ""
+	default:
+		if route.Namespace != gw.Namespace {
+			return false, fmt.Sprintf("listener only selects routes from namespace %q", gw.Namespace)
+		}
+		return true, ""
+	}
+}
+
+// labelsSelected reports whether the Listener's RouteSelector selects the
+// route's labels.
+func labelsSelected(l *v1alpha1.Listener, route *Route) (bool, string) {
+	selector, err := metav1.LabelSelectorAsSelector(&l.Routes.RouteSelector)
+	if err != nil {
+		return false, fmt.Sprintf("invalid route selector: %v", err)
+	}
+	if !selector.Matches(labels.Set(route.Labels)) {
+		return false, fmt.Sprintf("route labels do not match selector %q", selector)
+	}
+	return true, ""
+}
+
+// RouteAllowsGateway reports whether the route's Gateways field allows
+// the Gateway to use the route. If not, a message explains why.
+func RouteAllowsGateway(route *Route, gw *v1alpha1.Gateway) (bool, string) {
+	switch route.Gateways.Allow {
+	case v1alpha1.GatewayAllowAll:
+		return true, ""
+	case v1alpha1.GatewayAllowFromList:
+		for _, ref := range route.Gateways.GatewayRefs {
+			if ref.Namespace == gw.Namespace && ref.Name == gw.Name {
+				return true, ""
+			}
+		}
+		return false, fmt.Sprintf("gateway %s/%s is not in the route's gatewayRefs", gw.Namespace, gw.Name)
+	default:
+		if route.Namespace != gw.Namespace {
+			return false, fmt.Sprintf("route only allows gateways from namespace %q", route.Namespace)
+		}
+		return true, ""
+	}
+}
+
+// GatewayStatus returns the status reported for the route by the named
+// Gateway, or nil if that Gateway has not reported any.
+func (rt *Route) GatewayStatus(namespace, name string) *v1alpha1.RouteGatewayStatus {
+	for i := range rt.Status.Gateways {
+		ref := rt.Status.Gateways[i].GatewayRef
+		if ref.Namespace == namespace && ref.Name == name {
+			return &rt.Status.Gateways[i]
+		}
+	}
+	return nil
+}
+
+func describeHostnameMatch(m v1alpha1.HostnameMatch) string {
+	matchType := hostnameMatchType(m)
+	if matchType == v1alpha1.HostnameMatchAny {
+		return string(matchType)
+	}
+	return fmt.Sprintf("%s %q", matchType, m.Name)
+}
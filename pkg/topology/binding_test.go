@@ -0,0 +1,195 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+func httpRoute(namespace, name string, labels map[string]string, gateways v1alpha1.RouteGateways, hostnames ...v1alpha1.HTTPRouteHostname) *v1alpha1.HTTPRoute {
+	svc := "svc"
+	return &v1alpha1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+		Spec: v1alpha1.HTTPRouteSpec{
+			Gateways:  gateways,
+			Hostnames: hostnames,
+			Rules: []v1alpha1.HTTPRouteRule{{
+				ForwardTo: []v1alpha1.HTTPRouteForwardTo{{ServiceName: &svc}},
+			}},
+		},
+	}
+}
+
+func TestBind(t *testing.T) {
+	gw := &v1alpha1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "gw"},
+		Spec: v1alpha1.GatewaySpec{
+			GatewayClassName: "acme",
+			Listeners: []v1alpha1.Listener{{
+				Protocol: v1alpha1.HTTPProtocolType,
+				Port:     80,
+				Hostname: v1alpha1.HostnameMatch{Match: v1alpha1.HostnameMatchDomain, Name: "example.com"},
+				Routes: v1alpha1.RouteBindingSelector{
+					Kind: KindHTTPRoute,
+					RouteNamespaces: v1alpha1.RouteNamespaces{
+						From:     v1alpha1.RouteSelectSelector,
+						Selector: metav1.LabelSelector{MatchLabels: map[string]string{"expose": "true"}},
+					},
+					RouteSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				},
+			}},
+		},
+	}
+
+	fromList := v1alpha1.RouteGateways{
+		Allow:       v1alpha1.GatewayAllowFromList,
+		GatewayRefs: []v1alpha1.GatewayReference{{Namespace: "infra", Name: "gw"}},
+	}
+	web := map[string]string{"app": "web"}
+
+	res := &Resources{}
+	res.Add(gw)
+	res.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"expose": "true"}}})
+	res.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}})
+	res.Add(httpRoute("team-a", "bound", web, fromList, "www.example.com"))
+	res.Add(httpRoute("team-a", "wildcard", web, v1alpha1.RouteGateways{Allow: v1alpha1.GatewayAllowAll}, "*.example.com"))
+	res.Add(httpRoute("team-b", "ns-rejected", web, fromList))
+	res.Add(httpRoute("team-a", "labels-rejected", nil, fromList))
+	res.Add(httpRoute("team-a", "not-allowed", web, v1alpha1.RouteGateways{}))
+	res.Add(httpRoute("team-a", "hostname-rejected", web, fromList, "www.example.org"))
+	res.Add(httpRoute("team-b", "unrelated", nil, v1alpha1.RouteGateways{}))
+
+	b := res.Bind(gw)
+	if len(b.Listeners) != 1 {
+		t.Fatalf("got %d listener bindings, want 1", len(b.Listeners))
+	}
+	lb := b.Listeners[0]
+
+	var bound []string
+	for _, r := range lb.Routes {
+		bound = append(bound, r.Name)
+	}
+	if want := []string{"bound", "wildcard"}; !equal(bound, want) {
+		t.Errorf("bound routes = %v, want %v", bound, want)
+	}
+
+	want := map[string]RejectReason{
+		"ns-rejected":       ReasonNamespaceNotSelected,
+		"labels-rejected":   ReasonLabelsNotSelected,
+		"not-allowed":       ReasonGatewayNotAllowed,
+		"hostname-rejected": ReasonHostnameMismatch,
+	}
+	got := map[string]RejectReason{}
+	for _, rej := range lb.Rejected {
+		got[rej.Route.Name] = rej.Reason
+	}
+	if len(got) != len(want) {
+		t.Errorf("rejected routes = %v, want %v", got, want)
+	}
+	for name, reason := range want {
+		if got[name] != reason {
+			t.Errorf("route %q rejected with %q, want %q", name, got[name], reason)
+		}
+	}
+}
+
+func TestBindTLSRoutePorts(t *testing.T) {
+	gw := &v1alpha1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "gw"},
+		Spec: v1alpha1.GatewaySpec{
+			GatewayClassName: "acme",
+			Listeners: []v1alpha1.Listener{{
+				Protocol: v1alpha1.TLSProtocolType,
+				Port:     443,
+				Routes:   v1alpha1.RouteBindingSelector{Kind: KindTLSRoute},
+			}},
+		},
+	}
+	tlsRoute := func(name string, ports ...int32) *v1alpha1.TLSRoute {
+		route := &v1alpha1.TLSRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: name},
+			Spec: v1alpha1.TLSRouteSpec{
+				Gateways: v1alpha1.RouteGateways{Allow: v1alpha1.GatewayAllowAll},
+				Rules:    []v1alpha1.TLSRouteRule{{}},
+			},
+		}
+		for i := range ports {
+			route.Spec.Rules[0].Matches = append(route.Spec.Rules[0].Matches, v1alpha1.TLSRouteMatch{Port: &ports[i]})
+		}
+		return route
+	}
+
+	res := &Resources{}
+	res.Add(gw)
+	res.Add(tlsRoute("any-port"))
+	res.Add(tlsRoute("listener-port", 8443, 443))
+	res.Add(tlsRoute("other-port", 8443))
+
+	lb := res.Bind(gw).Listeners[0]
+
+	var bound []string
+	for _, r := range lb.Routes {
+		bound = append(bound, r.Name)
+	}
+	if want := []string{"any-port", "listener-port"}; !equal(bound, want) {
+		t.Errorf("bound routes = %v, want %v", bound, want)
+	}
+	if len(lb.Rejected) != 1 || lb.Rejected[0].Route.Name != "other-port" || lb.Rejected[0].Reason != ReasonPortMismatch {
+		t.Errorf("rejected routes = %+v, want other-port rejected with %q", lb.Rejected, ReasonPortMismatch)
+	}
+}
+
+func TestHostnamesIntersect(t *testing.T) {
+	tests := []struct {
+		match     v1alpha1.HostnameMatch
+		hostnames []string
+		want      bool
+	}{
+		{v1alpha1.HostnameMatch{}, []string{"foo.example.com"}, true},
+		{v1alpha1.HostnameMatch{Match: v1alpha1.HostnameMatchExact, Name: "foo.example.com"}, nil, true},
+		{v1alpha1.HostnameMatch{Match: v1alpha1.HostnameMatchExact, Name: "foo.example.com"}, []string{"FOO.example.com"}, true},
+		{v1alpha1.HostnameMatch{Match: v1alpha1.HostnameMatchExact, Name: "foo.example.com"}, []string{"*.example.com"}, true},
+		{v1alpha1.HostnameMatch{Match: v1alpha1.HostnameMatchExact, Name: "foo.bar.example.com"}, []string{"*.example.com"}, false},
+		{v1alpha1.HostnameMatch{Match: v1alpha1.HostnameMatchDomain, Name: "example.com"}, []string{"foo.example.com"}, true},
+		{v1alpha1.HostnameMatch{Match: v1alpha1.HostnameMatchDomain, Name: "example.com"}, []string{"example.com"}, false},
+		{v1alpha1.HostnameMatch{Match: v1alpha1.HostnameMatchDomain, Name: "example.com"}, []string{"*.example.com"}, true},
+		{v1alpha1.HostnameMatch{Name: "foo.example.com"}, []string{"bar.example.com"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := HostnamesIntersect(tt.match, tt.hostnames); got != tt.want {
+			t.Errorf("HostnamesIntersect(%+v, %v) = %v, want %v", tt.match, tt.hostnames, got, tt.want)
+		}
+	}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
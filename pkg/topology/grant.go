@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// Reference identifies one side of a reference between objects. An empty
+// group and "core" both select the core API group.
+type Reference struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// GrantAllows reports whether the grant allows from to reference to. The
+// grant must be in the namespace of to; the name of from is ignored.
+func GrantAllows(grant *v1alpha1.ReferenceGrant, from, to Reference) bool {
+	if grant.Namespace != to.Namespace {
+		return false
+	}
+
+	fromAllowed := false
+	for _, f := range grant.Spec.From {
+		if coreGroup(f.Group) == coreGroup(from.Group) && f.Kind == from.Kind && f.Namespace == from.Namespace {
+			fromAllowed = true
+			break
+		}
+	}
+	if !fromAllowed {
+		return false
+	}
+
+	for _, t := range grant.Spec.To {
+		if coreGroup(t.Group) == coreGroup(to.Group) && t.Kind == to.Kind && (t.Name == nil || *t.Name == to.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReferenceGranted reports whether from may reference to. References
+// within a namespace are always allowed; references across namespaces
+// need a ReferenceGrant in the namespace of to.
+func (r *Resources) ReferenceGranted(from, to Reference) bool {
+	if from.Namespace == to.Namespace {
+		return true
+	}
+	for i := range r.ReferenceGrants {
+		if GrantAllows(&r.ReferenceGrants[i], from, to) {
+			return true
+		}
+	}
+	return false
+}
+
+// CertificateReference returns the object referenced by a certificate
+// reference of an object in the given namespace. The reference selects a
+// Secret if it omits both the group and the kind.
+func CertificateReference(namespace string, ref v1alpha1.CertificateObjectReference) Reference {
+	to := Reference{Group: ref.Group, Kind: ref.Kind, Namespace: namespace, Name: ref.Name}
+	if to.Group == "" && to.Kind == "" {
+		to.Kind = "Secret"
+	}
+	if ref.Namespace != nil {
+		to.Namespace = *ref.Namespace
+	}
+	return to
+}
+
+// coreGroup normalizes the core API group to the empty string.
+func coreGroup(group string) string {
+	if group == "core" {
+		return ""
+	}
+	return group
+}
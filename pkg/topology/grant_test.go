@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+func TestReferenceGranted(t *testing.T) {
+	accounts := "accounts"
+	res := &Resources{ReferenceGrants: []v1alpha1.ReferenceGrant{{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "accounts"},
+		Spec: v1alpha1.ReferenceGrantSpec{
+			From: []v1alpha1.ReferenceGrantFrom{{Group: v1alpha1.GroupName, Kind: KindHTTPRoute, Namespace: "team-a"}},
+			To:   []v1alpha1.ReferenceGrantTo{{Group: "core", Kind: "Service", Name: &accounts}},
+		},
+	}}}
+
+	route := Reference{Group: v1alpha1.GroupName, Kind: KindHTTPRoute, Namespace: "team-a", Name: "web"}
+	service := Reference{Kind: "Service", Namespace: "shared", Name: "accounts"}
+
+	tests := []struct {
+		name     string
+		from, to Reference
+		want     bool
+	}{
+		{"granted", route, service, true},
+		{"same namespace", route, Reference{Kind: "Service", Namespace: "team-a", Name: "web"}, true},
+		{"other name", route, Reference{Kind: "Service", Namespace: "shared", Name: "billing"}, false},
+		{"other route kind", Reference{Group: v1alpha1.GroupName, Kind: KindTCPRoute, Namespace: "team-a"}, service, false},
+		{"other namespace", Reference{Group: v1alpha1.GroupName, Kind: KindHTTPRoute, Namespace: "team-b"}, service, false},
+	}
+
+	for _, tc := range tests {
+		if got := res.ReferenceGranted(tc.from, tc.to); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	shared := "shared"
+	cert := v1alpha1.CertificateObjectReference{
+		LocalObjectReference: v1alpha1.LocalObjectReference{Name: "wildcard"},
+		Namespace:            &shared,
+	}
+	want := Reference{Kind: "Secret", Namespace: "shared", Name: "wildcard"}
+	if got := CertificateReference("infra", cert); got != want {
+		t.Errorf("got certificate reference %+v, want %+v", got, want)
+	}
+}
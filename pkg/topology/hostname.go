@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"strings"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// ListenerMatchesHostname reports whether a client supplied hostname is
+// accepted by the Listener hostname match.
+func ListenerMatchesHostname(match v1alpha1.HostnameMatch, host string) bool {
+	switch hostnameMatchType(match) {
+	case v1alpha1.HostnameMatchAny:
+		return true
+	case v1alpha1.HostnameMatchDomain:
+		return strings.EqualFold(parentDomain(host), match.Name)
+	default:
+		return strings.EqualFold(host, match.Name)
+	}
+}
+
+// RouteMatchesHostname reports whether a client supplied hostname is
+// matched by a precise or wildcard route hostname.
+func RouteMatchesHostname(pattern, host string) bool {
+	if strings.HasPrefix(pattern, "*.") {
+		return strings.EqualFold(parentDomain(host), pattern[2:])
+	}
+	return strings.EqualFold(pattern, host)
+}
+
+// HostnamesIntersect reports whether any hostname that a route serves could
+// also be accepted by the Listener hostname match. A route without
+// hostnames serves every hostname.
+func HostnamesIntersect(match v1alpha1.HostnameMatch, routeHostnames []string) bool {
+	matchType := hostnameMatchType(match)
+	if len(routeHostnames) == 0 || matchType == v1alpha1.HostnameMatchAny {
+		return true
+	}
+
+	for _, h := range routeHostnames {
+		wildcard := strings.HasPrefix(h, "*.")
+
+		switch matchType {
+		case v1alpha1.HostnameMatchDomain:
+			if wildcard && strings.EqualFold(h[2:], match.Name) {
+				return true
+			}
+			if !wildcard && strings.EqualFold(parentDomain(h), match.Name) {
+				return true
+			}
+		default:
+			if RouteMatchesHostname(h, match.Name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hostnameMatchType returns the effective match type of a HostnameMatch,
+// applying the API defaults to objects that have not been through the
+// API server: an empty match is "Any", and a name without a match type
+// is "Exact".
+func hostnameMatchType(match v1alpha1.HostnameMatch) v1alpha1.HostnameMatchType {
+	switch {
+	case match.Match != "":
+		return match.Match
+	case match.Name == "":
+		return v1alpha1.HostnameMatchAny
+	default:
+		return v1alpha1.HostnameMatchExact
+	}
+}
+
+// parentDomain removes the leftmost DNS label from host.
+func parentDomain(host string) string {
+	i := strings.IndexByte(host, '.')
+	if i < 0 {
+		return ""
+	}
+	return host[i+1:]
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package topology evaluates the relationships between service-apis
+// objects: which Routes a Gateway Listener binds, why other Routes are
+// rejected, and which backends those Routes resolve to.
+package topology
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	"sigs.k8s.io/service-apis/pkg/client/clientset/versioned"
+)
+
+// Resources is a snapshot of the objects that take part in route binding.
+// It can be populated from a live cluster with Fetch, or from manifests
+// with Add.
+type Resources struct {
+	GatewayClasses  []v1alpha1.GatewayClass
+	Gateways        []v1alpha1.Gateway
+	HTTPRoutes      []v1alpha1.HTTPRoute
+	GRPCRoutes      []v1alpha1.GRPCRoute
+	TCPRoutes       []v1alpha1.TCPRoute
+	TLSRoutes       []v1alpha1.TLSRoute
+	UDPRoutes       []v1alpha1.UDPRoute
+	BackendPolicies []v1alpha1.BackendPolicy
+	ReferenceGrants []v1alpha1.ReferenceGrant
+
+	Namespaces []corev1.Namespace
+	Services   []corev1.Service
+	Secrets    []corev1.Secret
+
+	// Unlisted holds the kinds, by plural name such as "Services", that
+	// Fetch could not list because their CRD is not installed or the
+	// user may not list them, with the error. The snapshot holds no
+	// objects of these kinds, and they are reported as unknown rather
+	// than missing.
+	Unlisted map[string]error
+}
+
+// Add appends obj to the snapshot. Objects of kinds that do not take part
+// in route binding are ignored, and false is returned.
+func (r *Resources) Add(obj runtime.Object) bool {
+	switch o := obj.(type) {
+	case *v1alpha1.GatewayClass:
+		r.GatewayClasses = append(r.GatewayClasses, *o)
+	case *v1alpha1.Gateway:
+		r.Gateways = append(r.Gateways, *o)
+	case *v1alpha1.HTTPRoute:
+		r.HTTPRoutes = append(r.HTTPRoutes, *o)
+	case *v1alpha1.GRPCRoute:
+		r.GRPCRoutes = append(r.GRPCRoutes, *o)
+	case *v1alpha1.TCPRoute:
+		r.TCPRoutes = append(r.TCPRoutes, *o)
+	case *v1alpha1.TLSRoute:
+		r.TLSRoutes = append(r.TLSRoutes, *o)
+	case *v1alpha1.UDPRoute:
+		r.UDPRoutes = append(r.UDPRoutes, *o)
+	case *v1alpha1.BackendPolicy:
+		r.BackendPolicies = append(r.BackendPolicies, *o)
+	case *v1alpha1.ReferenceGrant:
+		r.ReferenceGrants = append(r.ReferenceGrants, *o)
+	case *corev1.Namespace:
+		r.Namespaces = append(r.Namespaces, *o)
+	case *corev1.Service:
+		r.Services = append(r.Services, *o)
+	case *corev1.Secret:
+		r.Secrets = append(r.Secrets, *o)
+	default:
+		return false
+	}
+	return true
+}
+
+// Fetch lists every object that takes part in route binding from the
+// cluster. Secrets are not listed because reading them requires broader
+// permissions than the rest of the snapshot; their absence is reported
+// as unknown rather than missing. Kinds whose CRD is not installed, or
+// that the user may not list, are recorded in Unlisted in the same way.
+func Fetch(ctx context.Context, gw versioned.Interface, kube kubernetes.Interface) (*Resources, error) {
+	r := &Resources{}
+	all := metav1.ListOptions{}
+	api := gw.NetworkingV1alpha1()
+
+	if classes, err := api.GatewayClasses().List(ctx, all); err == nil {
+		r.GatewayClasses = classes.Items
+	} else if err := r.unlisted("GatewayClasses", err); err != nil {
+		return nil, err
+	}
+
+	if gateways, err := api.Gateways(metav1.NamespaceAll).List(ctx, all); err == nil {
+		r.Gateways = gateways.Items
+	} else if err := r.unlisted("Gateways", err); err != nil {
+		return nil, err
+	}
+
+	if httpRoutes, err := api.HTTPRoutes(metav1.NamespaceAll).List(ctx, all); err == nil {
+		r.HTTPRoutes = httpRoutes.Items
+	} else if err := r.unlisted("HTTPRoutes", err); err != nil {
+		return nil, err
+	}
+
+	if grpcRoutes, err := api.GRPCRoutes(metav1.NamespaceAll).List(ctx, all); err == nil {
+		r.GRPCRoutes = grpcRoutes.Items
+	} else if err := r.unlisted("GRPCRoutes", err); err != nil {
+		return nil, err
+	}
+
+	if tcpRoutes, err := api.TCPRoutes(metav1.NamespaceAll).List(ctx, all); err == nil {
+		r.TCPRoutes = tcpRoutes.Items
+	} else if err := r.unlisted("TCPRoutes", err); err != nil {
+		return nil, err
+	}
+
+	if tlsRoutes, err := api.TLSRoutes(metav1.NamespaceAll).List(ctx, all); err == nil {
+		r.TLSRoutes = tlsRoutes.Items
+	} else if err := r.unlisted("TLSRoutes", err); err != nil {
+		return nil, err
+	}
+
+	if udpRoutes, err := api.UDPRoutes(metav1.NamespaceAll).List(ctx, all); err == nil {
+		r.UDPRoutes = udpRoutes.Items
+	} else if err := r.unlisted("UDPRoutes", err); err != nil {
+		return nil, err
+	}
+
+	if policies, err := api.BackendPolicies(metav1.NamespaceAll).List(ctx, all); err == nil {
+		r.BackendPolicies = policies.Items
+	} else if err := r.unlisted("BackendPolicies", err); err != nil {
+		return nil, err
+	}
+
+	// Without ReferenceGrants, every reference across namespaces is
+	// reported as not permitted.
+	if grants, err := api.ReferenceGrants(metav1.NamespaceAll).List(ctx, all); err == nil {
+		r.ReferenceGrants = grants.Items
+	} else if err := r.unlisted("ReferenceGrants", err); err != nil {
+		return nil, err
+	}
+
+	if namespaces, err := kube.CoreV1().Namespaces().List(ctx, all); err == nil {
+		r.Namespaces = namespaces.Items
+	} else if err := r.unlisted("Namespaces", err); err != nil {
+		return nil, err
+	}
+
+	if services, err := kube.CoreV1().Services(metav1.NamespaceAll).List(ctx, all); err == nil {
+		r.Services = services.Items
+	} else if err := r.unlisted("Services", err); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// unlisted records in r.Unlisted that kind could not be listed, if err
+// means that its CRD is not installed or that the user may not list it.
+// Other errors are returned.
+func (r *Resources) unlisted(kind string, err error) error {
+	if !apierrors.IsNotFound(err) && !apierrors.IsForbidden(err) && !meta.IsNoMatchError(err) {
+		return fmt.Errorf("listing %s: %w", kind, err)
+	}
+	if r.Unlisted == nil {
+		r.Unlisted = map[string]error{}
+	}
+	r.Unlisted[kind] = err
+	return nil
+}
+
+// Listed reports whether the snapshot holds every object of kind, given
+// by plural name. It is false for the kinds in Unlisted.
+func (r *Resources) Listed(kind string) bool {
+	_, ok := r.Unlisted[kind]
+	return !ok
+}
+
+// GatewayClass returns the named GatewayClass, or nil if it is not part of
+// the snapshot.
+func (r *Resources) GatewayClass(name string) *v1alpha1.GatewayClass {
+	for i := range r.GatewayClasses {
+		if r.GatewayClasses[i].Name == name {
+			return &r.GatewayClasses[i]
+		}
+	}
+	return nil
+}
+
+// Gateway returns the named Gateway, or nil if it is not part of the
+// snapshot.
+func (r *Resources) Gateway(namespace, name string) *v1alpha1.Gateway {
+	for i := range r.Gateways {
+		if r.Gateways[i].Namespace == namespace && r.Gateways[i].Name == name {
+			return &r.Gateways[i]
+		}
+	}
+	return nil
+}
+
+// FindGateway returns the named Gateway. If it is not part of the
+// snapshot, the error says that it was not found, or, when Gateways could
+// not be listed, that it cannot tell whether the Gateway exists.
+func (r *Resources) FindGateway(namespace, name string) (*v1alpha1.Gateway, error) {
+	if gw := r.Gateway(namespace, name); gw != nil {
+		return gw, nil
+	}
+	return nil, r.notFound("Gateways", fmt.Sprintf("gateway %s/%s", namespace, name))
+}
+
+// notFound returns the error for an object that is not part of the
+// snapshot. kind is the plural name that the object's kind is listed
+// under.
+func (r *Resources) notFound(kind, object string) error {
+	if err, ok := r.Unlisted[kind]; ok {
+		return fmt.Errorf("cannot tell whether %s exists: %s could not be listed: %w", object, kind, err)
+	}
+	return fmt.Errorf("%s not found", object)
+}
+
+// Namespace returns the named Namespace, or nil if it is not part of the
+// snapshot.
+func (r *Resources) Namespace(name string) *corev1.Namespace {
+	for i := range r.Namespaces {
+		if r.Namespaces[i].Name == name {
+			return &r.Namespaces[i]
+		}
+	}
+	return nil
+}
+
+// Service returns the named Service, or nil if it is not part of the
+// snapshot.
+func (r *Resources) Service(namespace, name string) *corev1.Service {
+	for i := range r.Services {
+		if r.Services[i].Namespace == namespace && r.Services[i].Name == name {
+			return &r.Services[i]
+		}
+	}
+	return nil
+}
+
+// Secret returns the named Secret, or nil if it is not part of the
+// snapshot.
+func (r *Resources) Secret(namespace, name string) *corev1.Secret {
+	for i := range r.Secrets {
+		if r.Secrets[i].Namespace == namespace && r.Secrets[i].Name == name {
+			return &r.Secrets[i]
+		}
+	}
+	return nil
+}
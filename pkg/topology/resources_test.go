@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+	gwfake "sigs.k8s.io/service-apis/pkg/client/clientset/versioned/fake"
+)
+
+// failList makes the fake clientset fail to list resource with err.
+func failList(c *k8stesting.Fake, resource string, err error) {
+	c.PrependReactor("list", resource, func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, err
+	})
+}
+
+// failClusterList makes the fake clientset fail to list resource across
+// all namespaces with err, as it does for a user whose RBAC only covers
+// some namespaces.
+func failClusterList(c *k8stesting.Fake, resource string, err error) {
+	c.PrependReactor("list", resource, func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return action.GetNamespace() == metav1.NamespaceAll, nil, err
+	})
+}
+
+func TestFetchUnlisted(t *testing.T) {
+	gw := gwfake.NewSimpleClientset(&v1alpha1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "acme"}})
+	kube := kubefake.NewSimpleClientset()
+	failList(&gw.Fake, "grpcroutes", apierrors.NewNotFound(schema.GroupResource{Group: v1alpha1.GroupName, Resource: "grpcroutes"}, ""))
+	failList(&gw.Fake, "referencegrants", apierrors.NewNotFound(schema.GroupResource{Group: v1alpha1.GroupName, Resource: "referencegrants"}, ""))
+	failList(&gw.Fake, "backendpolicies", apierrors.NewNotFound(schema.GroupResource{Group: v1alpha1.GroupName, Resource: "backendpolicies"}, ""))
+	failList(&kube.Fake, "namespaces", apierrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "", nil))
+	failList(&kube.Fake, "services", apierrors.NewForbidden(schema.GroupResource{Resource: "services"}, "", nil))
+
+	res, err := Fetch(context.Background(), gw, kube)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.GatewayClasses) != 1 {
+		t.Errorf("got %d GatewayClasses, want 1", len(res.GatewayClasses))
+	}
+	for _, kind := range []string{"GRPCRoutes", "BackendPolicies", "ReferenceGrants", "Namespaces", "Services"} {
+		if res.Listed(kind) {
+			t.Errorf("%s are listed, want unlisted", kind)
+		}
+	}
+	if !res.Listed("GatewayClasses") {
+		t.Errorf("GatewayClasses are unlisted: %v", res.Unlisted["GatewayClasses"])
+	}
+
+	web := "web"
+	route := NewHTTPRoute(&v1alpha1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "web"}})
+	if b := res.ResolveBackend(route, Backend{ServiceName: &web}); b.Resolved || !strings.Contains(b.Message, "unknown") {
+		t.Errorf("unlisted service resolved as %+v, want unknown", b)
+	}
+	shared := "shared"
+	if b := res.ResolveBackend(route, Backend{ServiceName: &web, Namespace: &shared}); !b.NotPermitted {
+		t.Errorf("service in another namespace resolved as %+v without ReferenceGrants, want not permitted", b)
+	}
+
+	gw = gwfake.NewSimpleClientset()
+	failList(&gw.Fake, "gateways", apierrors.NewInternalError(errors.New("etcd unavailable")))
+	if _, err := Fetch(context.Background(), gw, kubefake.NewSimpleClientset()); err == nil {
+		t.Error("Fetch ignored an internal error")
+	}
+}
+
+func TestFindUnlisted(t *testing.T) {
+	gw := gwfake.NewSimpleClientset(
+		&v1alpha1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "gateway"}},
+		&v1alpha1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "web"}},
+		&v1alpha1.TCPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "db"}},
+	)
+	for _, resource := range []string{"gateways", "httproutes"} {
+		failClusterList(&gw.Fake, resource, apierrors.NewForbidden(schema.GroupResource{Group: v1alpha1.GroupName, Resource: resource}, "", nil))
+	}
+
+	res, err := Fetch(context.Background(), gw, kubefake.NewSimpleClientset())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := res.FindGateway("team-a", "gateway"); err == nil || !strings.Contains(err.Error(), "cannot tell whether gateway team-a/gateway exists") {
+		t.Errorf("FindGateway of an unlisted Gateway returned %v, want cannot tell", err)
+	}
+	if _, err := res.FindRoute(KindHTTPRoute, "team-a", "web"); err == nil || !strings.Contains(err.Error(), "cannot tell whether HTTPRoute team-a/web exists") {
+		t.Errorf("FindRoute of an unlisted HTTPRoute returned %v, want cannot tell", err)
+	}
+	if route, err := res.FindRoute(KindTCPRoute, "team-a", "db"); err != nil || route.Name != "db" {
+		t.Errorf("FindRoute of a listed TCPRoute returned %v, %v", route, err)
+	}
+	if _, err := res.FindRoute(KindTCPRoute, "team-a", "cache"); err == nil || err.Error() != "TCPRoute team-a/cache not found" {
+		t.Errorf("FindRoute of a missing TCPRoute returned %v, want not found", err)
+	}
+}
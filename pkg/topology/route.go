@@ -0,0 +1,310 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/service-apis/apis/v1alpha1"
+)
+
+// Route kinds defined in the networking.x-k8s.io API group.
+const (
+	KindGRPCRoute = "GRPCRoute"
+	KindHTTPRoute = "HTTPRoute"
+	KindTCPRoute  = "TCPRoute"
+	KindTLSRoute  = "TLSRoute"
+	KindUDPRoute  = "UDPRoute"
+)
+
+// Route is a protocol-independent view of a route object that holds the
+// fields that take part in binding to a Gateway.
+type Route struct {
+	metav1.ObjectMeta
+
+	// Kind is the kind of the underlying route object.
+	Kind string
+
+	// Gateways is the route's side of the binding handshake.
+	Gateways v1alpha1.RouteGateways
+
+	// Hostnames are the HTTP hostnames or TLS SNIs the route serves. An
+	// empty list means the route serves any hostname.
+	Hostnames []string
+
+	// Ports are the destination ports the route's matches accept. An
+	// empty list means the route accepts connections on any port.
+	Ports []int32
+
+	// Rules holds the backends of each rule, in rule order.
+	Rules []Rule
+
+	// Status is the status reported by Gateway controllers.
+	Status v1alpha1.RouteStatus
+
+	// Object is the underlying route object.
+	Object runtime.Object
+}
+
+// Rule is a protocol-independent view of a route rule.
+type Rule struct {
+	ForwardTo []Backend
+}
+
+// Backend is a protocol-independent view of a route forwarding target.
+type Backend struct {
+	ServiceName *string
+	BackendRef  *v1alpha1.LocalObjectReference
+	Namespace   *string
+	Port        *int32
+	Weight      int32
+}
+
+// Routes returns every route in the snapshot, ordered by kind, namespace
+// and name.
+func (r *Resources) Routes() []*Route {
+	var routes []*Route
+
+	for i := range r.HTTPRoutes {
+		routes = append(routes, NewHTTPRoute(&r.HTTPRoutes[i]))
+	}
+	for i := range r.GRPCRoutes {
+		routes = append(routes, NewGRPCRoute(&r.GRPCRoutes[i]))
+	}
+	for i := range r.TCPRoutes {
+		routes = append(routes, NewTCPRoute(&r.TCPRoutes[i]))
+	}
+	for i := range r.TLSRoutes {
+		routes = append(routes, NewTLSRoute(&r.TLSRoutes[i]))
+	}
+	for i := range r.UDPRoutes {
+		routes = append(routes, NewUDPRoute(&r.UDPRoutes[i]))
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Kind != routes[j].Kind {
+			return routes[i].Kind < routes[j].Kind
+		}
+		if routes[i].Namespace != routes[j].Namespace {
+			return routes[i].Namespace < routes[j].Namespace
+		}
+		return routes[i].Name < routes[j].Name
+	})
+
+	return routes
+}
+
+// Route returns the route with the given kind, namespace and name, or nil
+// if it is not part of the snapshot.
+func (r *Resources) Route(kind, namespace, name string) *Route {
+	for _, route := range r.Routes() {
+		if route.Kind == kind && route.Namespace == namespace && route.Name == name {
+			return route
+		}
+	}
+	return nil
+}
+
+// FindRoute returns the route with the given kind, namespace and name. If
+// it is not part of the snapshot, the error says that it was not found,
+// or, when routes of that kind could not be listed, that it cannot tell
+// whether the route exists.
+func (r *Resources) FindRoute(kind, namespace, name string) (*Route, error) {
+	if route := r.Route(kind, namespace, name); route != nil {
+		return route, nil
+	}
+	return nil, r.notFound(kind+"s", fmt.Sprintf("%s %s/%s", kind, namespace, name))
+}
+
+// NewHTTPRoute returns the Route view of an HTTPRoute.
+func NewHTTPRoute(route *v1alpha1.HTTPRoute) *Route {
+	r := &Route{
+		ObjectMeta: route.ObjectMeta,
+		Kind:       KindHTTPRoute,
+		Gateways:   route.Spec.Gateways,
+		Status:     route.Status.RouteStatus,
+		Object:     route,
+	}
+
+	for _, h := range route.Spec.Hostnames {
+		r.Hostnames = append(r.Hostnames, string(h))
+	}
+
+	for _, rule := range route.Spec.Rules {
+		var backends []Backend
+		for _, f := range rule.ForwardTo {
+			backends = append(backends, Backend{
+				ServiceName: f.ServiceName,
+				BackendRef:  f.BackendRef,
+				Namespace:   f.Namespace,
+				Port:        f.Port,
+				Weight:      f.Weight,
+			})
+		}
+		r.Rules = append(r.Rules, Rule{ForwardTo: backends})
+	}
+
+	return r
+}
+
+// NewGRPCRoute returns the Route view of a GRPCRoute.
+func NewGRPCRoute(route *v1alpha1.GRPCRoute) *Route {
+	r := &Route{
+		ObjectMeta: route.ObjectMeta,
+		Kind:       KindGRPCRoute,
+		Gateways:   route.Spec.Gateways,
+		Status:     route.Status.RouteStatus,
+		Object:     route,
+	}
+
+	for _, h := range route.Spec.Hostnames {
+		r.Hostnames = append(r.Hostnames, string(h))
+	}
+
+	for _, rule := range route.Spec.Rules {
+		r.Rules = append(r.Rules, Rule{ForwardTo: forwardTo(rule.ForwardTo)})
+	}
+
+	return r
+}
+
+// NewTCPRoute returns the Route view of a TCPRoute. The ports of all the
+// route's matches are collected as its ports, unless any match accepts
+// every port.
+func NewTCPRoute(route *v1alpha1.TCPRoute) *Route {
+	r := &Route{
+		ObjectMeta: route.ObjectMeta,
+		Kind:       KindTCPRoute,
+		Gateways:   route.Spec.Gateways,
+		Status:     route.Status.RouteStatus,
+		Object:     route,
+	}
+
+	var ports [][]*int32
+	for _, rule := range route.Spec.Rules {
+		var rulePorts []*int32
+		for _, m := range rule.Matches {
+			rulePorts = append(rulePorts, m.Port)
+		}
+		ports = append(ports, rulePorts)
+		r.Rules = append(r.Rules, Rule{ForwardTo: forwardTo(rule.ForwardTo)})
+	}
+	r.Ports = matchPorts(ports)
+
+	return r
+}
+
+// NewTLSRoute returns the Route view of a TLSRoute. The SNIs and ports of
+// all the route's matches are collected as its hostnames and ports, unless
+// any match accepts every SNI or every port respectively.
+func NewTLSRoute(route *v1alpha1.TLSRoute) *Route {
+	r := &Route{
+		ObjectMeta: route.ObjectMeta,
+		Kind:       KindTLSRoute,
+		Gateways:   route.Spec.Gateways,
+		Status:     route.Status.RouteStatus,
+		Object:     route,
+	}
+
+	anySNI := false
+	var ports [][]*int32
+	for _, rule := range route.Spec.Rules {
+		if len(rule.Matches) == 0 {
+			anySNI = true
+		}
+		var rulePorts []*int32
+		for _, m := range rule.Matches {
+			if len(m.SNIs) == 0 {
+				anySNI = true
+			}
+			r.Hostnames = append(r.Hostnames, m.SNIs...)
+			rulePorts = append(rulePorts, m.Port)
+		}
+		ports = append(ports, rulePorts)
+		r.Rules = append(r.Rules, Rule{ForwardTo: forwardTo(rule.ForwardTo)})
+	}
+
+	if anySNI {
+		r.Hostnames = nil
+	}
+	r.Ports = matchPorts(ports)
+
+	return r
+}
+
+// NewUDPRoute returns the Route view of a UDPRoute. The ports of all the
+// route's matches are collected as its ports, unless any match accepts
+// every port.
+func NewUDPRoute(route *v1alpha1.UDPRoute) *Route {
+	r := &Route{
+		ObjectMeta: route.ObjectMeta,
+		Kind:       KindUDPRoute,
+		Gateways:   route.Spec.Gateways,
+		Status:     route.Status.RouteStatus,
+		Object:     route,
+	}
+
+	var ports [][]*int32
+	for _, rule := range route.Spec.Rules {
+		var rulePorts []*int32
+		for _, m := range rule.Matches {
+			rulePorts = append(rulePorts, m.Port)
+		}
+		ports = append(ports, rulePorts)
+		r.Rules = append(r.Rules, Rule{ForwardTo: forwardTo(rule.ForwardTo)})
+	}
+	r.Ports = matchPorts(ports)
+
+	return r
+}
+
+func forwardTo(targets []v1alpha1.RouteForwardTo) []Backend {
+	var backends []Backend
+	for _, f := range targets {
+		backends = append(backends, Backend{
+			ServiceName: f.ServiceName,
+			BackendRef:  f.BackendRef,
+			Namespace:   f.Namespace,
+			Port:        f.Port,
+			Weight:      f.Weight,
+		})
+	}
+	return backends
+}
+
+// matchPorts returns the ports accepted by a route, given the port of
+// each match of each rule. It returns nil if a rule has no matches or a
+// match has no port, because the route then accepts every port.
+func matchPorts(rules [][]*int32) []int32 {
+	var ports []int32
+	for _, matches := range rules {
+		if len(matches) == 0 {
+			return nil
+		}
+		for _, port := range matches {
+			if port == nil {
+				return nil
+			}
+			ports = append(ports, *port)
+		}
+	}
+	return ports
+}